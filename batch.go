@@ -0,0 +1,307 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"golang.org/x/time/rate"
+)
+
+// DefaultMulticallAddress is the address of the Multicall3 contract that is
+// deployed at the same address on most chains.
+var DefaultMulticallAddress = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+// defaultBatchSize is the maximal number of calls in a single request of
+// batch methods if Options.BatchSize is not set.
+const defaultBatchSize = 100
+
+var multicallABI = func() *abi.ABI {
+	a, err := abi.JSON(strings.NewReader(`[{
+		"type": "function",
+		"name": "aggregate3",
+		"stateMutability": "payable",
+		"inputs": [{"name": "calls", "type": "tuple[]", "components": [
+			{"name": "target", "type": "address"},
+			{"name": "allowFailure", "type": "bool"},
+			{"name": "callData", "type": "bytes"}
+		]}],
+		"outputs": [{"name": "returnData", "type": "tuple[]", "components": [
+			{"name": "success", "type": "bool"},
+			{"name": "returnData", "type": "bytes"}
+		]}]
+	}]`))
+	if err != nil {
+		panic(err)
+	}
+	return &a
+}()
+
+type multicallCall struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+type multicallResult struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// BatchResult is the result of a single call of batch methods.
+type BatchResult[T any] struct {
+	Value T
+	Err   error
+}
+
+type batchCall struct {
+	to   common.Address
+	data []byte
+}
+
+type batchCallResult struct {
+	data []byte
+	err  error
+}
+
+// revertError is the error of a call in a multicall that reverted, with the
+// revert data that can be decoded as a contract error.
+type revertError struct {
+	data []byte
+}
+
+func (e *revertError) Error() string {
+	return "execution reverted"
+}
+
+func (e *revertError) ErrorCode() int {
+	return 3
+}
+
+func (e *revertError) ErrorData() any {
+	return e.data
+}
+
+// batch calls the contract method with every list of arguments and decodes
+// the results. Calls are aggregated through the Multicall3 contract if it is
+// deployed, or sent as JSON-RPC batch requests if the backend is an
+// ethclient.Client, or are sent one by one with the backend otherwise. The error is returned only if the
+// requests fail, while errors of individual calls are set in results.
+func batch[T any](ctx context.Context, c *Client, contract common.Address, a *abi.ABI, method string, args [][]any, decode func([]any) T) ([]BatchResult[T], error) {
+	calls := make([]batchCall, 0, len(args))
+	for _, arg := range args {
+		data, err := a.Pack(method, arg...)
+		if err != nil {
+			return nil, fmt.Errorf("pack %s call: %w", method, err)
+		}
+		calls = append(calls, batchCall{to: contract, data: data})
+	}
+
+	results, err := c.callBatch(ctx, calls)
+	if err != nil {
+		return nil, err
+	}
+
+	abis := c.errorABIsFor(a, common.Address{})
+	s := make([]BatchResult[T], 0, len(results))
+	for _, r := range results {
+		if r.err != nil {
+			s = append(s, BatchResult[T]{Err: unpackError(r.err, abis...)})
+			continue
+		}
+		out, err := a.Unpack(method, r.data)
+		if err != nil {
+			s = append(s, BatchResult[T]{Err: fmt.Errorf("unpack %s result: %w", method, err)})
+			continue
+		}
+		s = append(s, BatchResult[T]{Value: decode(out)})
+	}
+	return s, nil
+}
+
+// callBatch calls all calls in requests of the batch size.
+func (c *Client) callBatch(ctx context.Context, calls []batchCall) ([]batchCallResult, error) {
+	multicall, err := c.hasMulticall(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	size := c.options.BatchSize
+	if size <= 0 {
+		size = defaultBatchSize
+	}
+	// every call in a json-rpc batch request is counted as a request, so
+	// the batch can not be larger than the rate limit burst
+	if !multicall && c.rpcClient != nil && c.callsLimiter.Limit() != rate.Inf {
+		size = min(size, c.callsLimiter.Burst())
+	}
+
+	results := make([]batchCallResult, 0, len(calls))
+	for len(calls) > 0 {
+		chunk := calls[:min(size, len(calls))]
+		calls = calls[len(chunk):]
+
+		var r []batchCallResult
+		switch {
+		case multicall:
+			r, err = c.multicall(ctx, chunk)
+		case c.rpcClient != nil:
+			r, err = c.rpcBatch(ctx, chunk)
+		default:
+			r = c.callEach(ctx, chunk)
+		}
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, r...)
+	}
+	return results, nil
+}
+
+func (c *Client) multicallAddress() common.Address {
+	if c.options.MulticallAddress != (common.Address{}) {
+		return c.options.MulticallAddress
+	}
+	return DefaultMulticallAddress
+}
+
+// hasMulticall reports if the Multicall3 contract is deployed. The result is
+// cached after the first successful check.
+func (c *Client) hasMulticall(ctx context.Context) (bool, error) {
+	c.multicallMu.Lock()
+	defer c.multicallMu.Unlock()
+
+	if c.multicallDeployed != nil {
+		return *c.multicallDeployed, nil
+	}
+
+	code, err := c.backend.CodeAt(ctx, c.multicallAddress(), nil)
+	if err != nil {
+		return false, fmt.Errorf("get multicall contract code: %w", err)
+	}
+	deployed := len(code) > 0
+	c.multicallDeployed = &deployed
+	return deployed, nil
+}
+
+func (c *Client) multicall(ctx context.Context, calls []batchCall) ([]batchCallResult, error) {
+	mc := make([]multicallCall, 0, len(calls))
+	for _, call := range calls {
+		mc = append(mc, multicallCall{
+			Target:       call.to,
+			AllowFailure: true,
+			CallData:     call.data,
+		})
+	}
+	data, err := multicallABI.Pack("aggregate3", mc)
+	if err != nil {
+		return nil, fmt.Errorf("pack multicall: %w", err)
+	}
+
+	address := c.multicallAddress()
+	out, err := c.backend.CallContract(ctx, ethereum.CallMsg{
+		To:   &address,
+		Data: data,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("multicall: %w", err)
+	}
+
+	values, err := multicallABI.Unpack("aggregate3", out)
+	if err != nil {
+		return nil, fmt.Errorf("unpack multicall result: %w", err)
+	}
+	mr := convertValue[[]multicallResult](values[0])
+	if len(mr) != len(calls) {
+		return nil, fmt.Errorf("multicall returned %v results for %v calls", len(mr), len(calls))
+	}
+
+	results := make([]batchCallResult, 0, len(mr))
+	for _, r := range mr {
+		if !r.Success {
+			results = append(results, batchCallResult{err: &revertError{data: r.ReturnData}})
+			continue
+		}
+		results = append(results, batchCallResult{data: r.ReturnData})
+	}
+	return results, nil
+}
+
+// rpcBatch sends calls in a single JSON-RPC batch request, retried in the
+// same way as backend calls and limited as one request for every call.
+func (c *Client) rpcBatch(ctx context.Context, calls []batchCall) ([]batchCallResult, error) {
+	elems := make([]rpc.BatchElem, 0, len(calls))
+	for _, call := range calls {
+		elems = append(elems, rpc.BatchElem{
+			Method: "eth_call",
+			Args: []any{map[string]any{
+				"to":    call.to,
+				"input": hexutil.Bytes(call.data),
+			}, "latest"},
+			Result: new(hexutil.Bytes),
+		})
+	}
+
+	send := func(int) (struct{}, error) {
+		return rateLimitN(ctx, c.callsLimiter, len(elems), func() (struct{}, error) {
+			return struct{}{}, c.rpcClient.BatchCallContext(ctx, elems)
+		})
+	}
+	var err error
+	if c.options.Retry != nil {
+		_, err = retry(ctx, c.options.Retry.withDefaults(), send)
+	} else {
+		_, err = send(1)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("batch request: %w", err)
+	}
+
+	results := make([]batchCallResult, 0, len(elems))
+	for _, e := range elems {
+		if e.Error != nil {
+			results = append(results, batchCallResult{err: e.Error})
+			continue
+		}
+		results = append(results, batchCallResult{data: *e.Result.(*hexutil.Bytes)})
+	}
+	return results, nil
+}
+
+// callEach sends calls one by one.
+func (c *Client) callEach(ctx context.Context, calls []batchCall) []batchCallResult {
+	results := make([]batchCallResult, 0, len(calls))
+	for _, call := range calls {
+		to := call.to
+		data, err := c.backend.CallContract(ctx, ethereum.CallMsg{
+			To:   &to,
+			Data: call.data,
+		}, nil)
+		results = append(results, batchCallResult{data: data, err: err})
+	}
+	return results
+}
+
+// uidArgs returns arguments of calls with a single uid argument.
+func uidArgs(uids []UID) [][]any {
+	args := make([][]any, 0, len(uids))
+	for _, uid := range uids {
+		args = append(args, []any{uid})
+	}
+	return args
+}
+
+// convertValue converts the unpacked value to the type.
+func convertValue[T any](v any) T {
+	return *abi.ConvertType(v, new(T)).(*T)
+}
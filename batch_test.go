@@ -0,0 +1,336 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"resenje.org/eas"
+	"resenje.org/eas/eastest"
+)
+
+func TestEASContract_GetAttestations(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, &eastest.Options{AutoCommit: true, HTTP: true})
+	c := h.Client()
+
+	_, waitRegister, err := c.SchemaRegistry.Register(ctx, "string message", [20]byte{}, true)
+	assertNilError(t, err)
+	registered, err := waitRegister(ctx)
+	assertNilError(t, err)
+
+	var uids []eas.UID
+	for _, message := range []string{"one", "two", "three"} {
+		_, wait, err := c.EAS.Attest(ctx, registered.UID, &eas.AttestOptions{Revocable: true}, message)
+		assertNilError(t, err)
+		r, err := wait(ctx)
+		assertNilError(t, err)
+		uids = append(uids, r.UID)
+	}
+
+	_, waitRevoke, err := c.EAS.Revoke(ctx, registered.UID, uids[1], nil)
+	assertNilError(t, err)
+	_, err = waitRevoke(ctx)
+	assertNilError(t, err)
+
+	_, waitTimestamp, err := c.EAS.Timestamp(ctx, uids[0])
+	assertNilError(t, err)
+	_, err = waitTimestamp(ctx)
+	assertNilError(t, err)
+
+	_, waitRevokeOffchain, err := c.EAS.RevokeOffchain(ctx, uids[2])
+	assertNilError(t, err)
+	_, err = waitRevokeOffchain(ctx)
+	assertNilError(t, err)
+
+	unknown := eas.HexDecodeUID("0x2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a")
+	uids = append(uids, unknown)
+
+	newBatchClient := func(t *testing.T, backend eas.Backend) *eas.Client {
+		t.Helper()
+
+		client, err := eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EAS, &eas.Options{
+			Backend:   backend,
+			BatchSize: 2,
+		})
+		assertNilError(t, err)
+		return client
+	}
+
+	check := func(t *testing.T, client *eas.Client) {
+		t.Helper()
+
+		attestations, err := client.EAS.GetAttestations(ctx, uids)
+		assertNilError(t, err)
+		assertEqual(t, "attestations count", len(attestations), len(uids))
+		valid, err := client.EAS.AreAttestationsValid(ctx, uids)
+		assertNilError(t, err)
+		timestamps, err := client.EAS.GetTimestamps(ctx, uids)
+		assertNilError(t, err)
+		revocations, err := client.EAS.GetRevokeOffchains(ctx, c.Address(), uids)
+		assertNilError(t, err)
+
+		for i, uid := range uids {
+			want, err := c.EAS.GetAttestation(ctx, uid)
+			assertNilError(t, err)
+			assertNilError(t, attestations[i].Err)
+			assertEqual(t, "attestation", attestations[i].Value, want)
+
+			wantValid, err := c.EAS.IsAttestationValid(ctx, uid)
+			assertNilError(t, err)
+			assertNilError(t, valid[i].Err)
+			assertEqual(t, "valid", valid[i].Value, wantValid)
+
+			wantTimestamp, err := c.EAS.GetTimestamp(ctx, uid)
+			assertNilError(t, err)
+			assertNilError(t, timestamps[i].Err)
+			assertEqual(t, "timestamp", timestamps[i].Value, wantTimestamp)
+
+			wantRevocation, err := c.EAS.GetRevokeOffchain(ctx, c.Address(), uid)
+			assertNilError(t, err)
+			assertNilError(t, revocations[i].Err)
+			assertEqual(t, "offchain revocation", revocations[i].Value, wantRevocation)
+		}
+
+		assertEqual(t, "revoked", attestations[1].Value.IsRevoked(), true)
+		assertEqual(t, "timestamped", timestamps[0].Value != 0, true)
+		assertEqual(t, "revoked offchain", revocations[2].Value != 0, true)
+
+		schemas, err := client.SchemaRegistry.GetSchemas(ctx, []eas.UID{registered.UID, unknown})
+		assertNilError(t, err)
+		for i, uid := range []eas.UID{registered.UID, unknown} {
+			want, err := c.SchemaRegistry.GetSchema(ctx, uid)
+			assertNilError(t, err)
+			assertNilError(t, schemas[i].Err)
+			assertEqual(t, "schema", schemas[i].Value, want)
+		}
+
+		empty, err := client.EAS.GetAttestations(ctx, nil)
+		assertNilError(t, err)
+		assertEqual(t, "empty", len(empty), 0)
+	}
+
+	t.Run("multicall", func(t *testing.T) {
+		backend := newMulticallBackend(t, h.Backend())
+		check(t, newBatchClient(t, backend))
+
+		// four batch calls of four uids in requests of two calls and one call
+		// of two schemas
+		assertEqual(t, "multicalls", backend.calls.Load(), int32(4*2+1))
+	})
+
+	t.Run("multicall revert", func(t *testing.T) {
+		backend := newMulticallBackend(t, h.Backend())
+		backend.revert = crypto.Keccak256([]byte("InvalidAttestation()"))[:4]
+		backend.revertUID = uids[1]
+
+		attestations, err := newBatchClient(t, backend).EAS.GetAttestations(ctx, uids)
+		assertNilError(t, err)
+
+		assertNilError(t, attestations[0].Err)
+		assertErrorIs(t, attestations[1].Err, eas.ErrInvalidAttestation)
+		assertNilError(t, attestations[2].Err)
+		assertEqual(t, "uid", attestations[2].Value.UID, uids[2])
+	})
+
+	t.Run("json-rpc batch", func(t *testing.T) {
+		check(t, newBatchClient(t, h.EthClient()))
+	})
+
+	t.Run("wrapped ethclient", func(t *testing.T) {
+		// calls are sent one by one with the backend that wraps the client
+		backend := &callCountBackend{Client: h.EthClient()}
+		client := newBatchClient(t, backend)
+		backend.calls.Store(0)
+
+		attestations, err := client.EAS.GetAttestations(ctx, uids)
+		assertNilError(t, err)
+		assertEqual(t, "attestations", len(attestations), len(uids))
+		assertEqual(t, "calls", backend.calls.Load(), int32(len(uids)))
+	})
+
+	t.Run("calls", func(t *testing.T) {
+		check(t, newBatchClient(t, h.Backend()))
+	})
+
+	t.Run("json-rpc batch with retry", func(t *testing.T) {
+		transport := new(faultTransport)
+		transport.failures.Store(1)
+		rpcClient, err := rpc.DialOptions(ctx, h.Endpoint(), rpc.WithHTTPClient(&http.Client{Transport: transport}))
+		assertNilError(t, err)
+		defer rpcClient.Close()
+
+		newClient := func(t *testing.T, retry *eas.RetryPolicy) *eas.Client {
+			t.Helper()
+
+			client, err := eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EAS, &eas.Options{
+				Backend: ethclient.NewClient(rpcClient),
+				Retry:   retry,
+				RateLimits: &eas.RateLimits{
+					Calls: &eas.RateLimit{RequestsPerSecond: 1000},
+				},
+			})
+			assertNilError(t, err)
+			return client
+		}
+
+		// the transient error is returned without retries
+		_, err = newClient(t, nil).EAS.GetAttestations(ctx, uids)
+		assertErrorIs(t, err, errUnavailable)
+		assertEqual(t, "batch requests", transport.batches.Load(), int32(1))
+
+		transport.batches.Store(0)
+		transport.failures.Store(1)
+
+		attestations, err := newClient(t, &eas.RetryPolicy{
+			MaxAttempts:    2,
+			InitialBackoff: time.Millisecond,
+		}).EAS.GetAttestations(ctx, uids)
+		assertNilError(t, err)
+		assertEqual(t, "batch requests", transport.batches.Load(), int32(2))
+		for i, uid := range uids[:3] {
+			assertNilError(t, attestations[i].Err)
+			assertEqual(t, "uid", attestations[i].Value.UID, uid)
+		}
+	})
+}
+
+// callCountBackend counts contract calls of the wrapped client.
+type callCountBackend struct {
+	*ethclient.Client
+	calls atomic.Int32
+}
+
+func (b *callCountBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	b.calls.Add(1)
+	return b.Client.CallContract(ctx, call, blockNumber)
+}
+
+var errUnavailable = errors.New("unavailable")
+
+// faultTransport fails the number of JSON-RPC batch requests with a transient
+// network error.
+type faultTransport struct {
+	failures atomic.Int32
+	batches  atomic.Int32
+}
+
+func (t *faultTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	if bytes.HasPrefix(bytes.TrimSpace(body), []byte("[")) {
+		t.batches.Add(1)
+		if t.failures.Add(-1) >= 0 {
+			return nil, &net.OpError{Op: "read", Net: "tcp", Err: errUnavailable}
+		}
+	}
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+var testMulticallAddress = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+var testMulticallABI = func() abi.ABI {
+	a, err := abi.JSON(strings.NewReader(`[{
+		"type": "function",
+		"name": "aggregate3",
+		"inputs": [{"name": "calls", "type": "tuple[]", "components": [
+			{"name": "target", "type": "address"},
+			{"name": "allowFailure", "type": "bool"},
+			{"name": "callData", "type": "bytes"}
+		]}],
+		"outputs": [{"name": "returnData", "type": "tuple[]", "components": [
+			{"name": "success", "type": "bool"},
+			{"name": "returnData", "type": "bytes"}
+		]}]
+	}]`))
+	if err != nil {
+		panic(err)
+	}
+	return a
+}()
+
+// multicallBackend emulates the Multicall3 contract by executing aggregated
+// calls on the wrapped backend.
+type multicallBackend struct {
+	eas.Backend
+	t         *testing.T
+	calls     atomic.Int32
+	revert    []byte
+	revertUID eas.UID
+}
+
+func newMulticallBackend(t *testing.T, backend eas.Backend) *multicallBackend {
+	return &multicallBackend{Backend: backend, t: t}
+}
+
+func (b *multicallBackend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	if contract == testMulticallAddress {
+		return []byte{0x60, 0x80}, nil
+	}
+	return b.Backend.CodeAt(ctx, contract, blockNumber)
+}
+
+func (b *multicallBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if call.To == nil || *call.To != testMulticallAddress {
+		return b.Backend.CallContract(ctx, call, blockNumber)
+	}
+	b.calls.Add(1)
+
+	method := testMulticallABI.Methods["aggregate3"]
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		b.t.Fatal(err)
+	}
+	calls := *abi.ConvertType(args[0], new([]struct {
+		Target       common.Address
+		AllowFailure bool
+		CallData     []byte
+	})).(*[]struct {
+		Target       common.Address
+		AllowFailure bool
+		CallData     []byte
+	})
+
+	type result struct {
+		Success    bool
+		ReturnData []byte
+	}
+	results := make([]result, 0, len(calls))
+	for _, c := range calls {
+		if b.revert != nil && bytes.Contains(c.CallData, b.revertUID[:]) {
+			results = append(results, result{ReturnData: b.revert})
+			continue
+		}
+		out, err := b.Backend.CallContract(ctx, ethereum.CallMsg{To: &c.Target, Data: c.CallData}, blockNumber)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result{Success: true, ReturnData: out})
+	}
+	return method.Outputs.Pack(results)
+}
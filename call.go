@@ -0,0 +1,130 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Call is a contract method call that can be signed and sent as a transaction
+// by any account, for example on an offline machine or by a multisig wallet.
+type Call struct {
+	To    common.Address
+	Data  []byte
+	Value *big.Int
+
+	abi *abi.ABI
+}
+
+func (c *Client) newCall(contract common.Address, a *abi.ABI, value *big.Int, method string, args ...any) (*Call, error) {
+	data, err := a.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("pack arguments: %w", err)
+	}
+	if value == nil {
+		value = big.NewInt(0)
+	}
+	return &Call{
+		To:    contract,
+		Data:  data,
+		Value: value,
+		abi:   a,
+	}, nil
+}
+
+// UnsignedTransaction returns the transaction of the call that should be
+// signed by the sender. The nonce is the pending nonce of the sender and the
+// gas is estimated for the sender, if the gas limit is not set in client
+// options.
+func (c *Client) UnsignedTransaction(ctx context.Context, call *Call, from common.Address) (*types.Transaction, error) {
+	nonce, err := c.backend.PendingNonceAt(ctx, from)
+	if err != nil {
+		return nil, fmt.Errorf("get pending nonce: %w", err)
+	}
+
+	gas := c.options.GasLimit
+	if gas == 0 {
+		gas, err = c.backend.EstimateGas(ctx, ethereum.CallMsg{
+			From:  from,
+			To:    &call.To,
+			Value: call.Value,
+			Data:  call.Data,
+		})
+		if err != nil {
+			if call.abi != nil {
+				err = unpackError(err, c.errorABIsFor(call.abi, common.Address{})...)
+			}
+			return nil, fmt.Errorf("estimate gas: %w", err)
+		}
+	}
+
+	header, err := c.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("get latest header: %w", err)
+	}
+
+	if header.BaseFee == nil {
+		gasPrice, err := c.backend.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("suggest gas price: %w", err)
+		}
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    nonce,
+			GasPrice: gasPrice,
+			Gas:      gas,
+			To:       &call.To,
+			Value:    call.Value,
+			Data:     call.Data,
+		}), nil
+	}
+
+	gasTipCap := c.options.GasTipCap
+	if gasTipCap == nil {
+		gasTipCap, err = c.backend.SuggestGasTipCap(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("suggest gas tip cap: %w", err)
+		}
+	}
+
+	gasFeeCap := c.options.GasFeeCap
+	if gasFeeCap == nil {
+		gasFeeCap = new(big.Int).Add(gasTipCap, new(big.Int).Mul(header.BaseFee, big.NewInt(2)))
+	}
+
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   c.chainID,
+		Nonce:     nonce,
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+		Gas:       gas,
+		To:        &call.To,
+		Value:     call.Value,
+		Data:      call.Data,
+	}), nil
+}
+
+// SendRawTransaction sends the binary encoded signed transaction. Functions
+// returned by contract Wait methods can be used to wait for the transaction to
+// be mined and to parse its logs.
+func (c *Client) SendRawTransaction(ctx context.Context, rawTx []byte) (*types.Transaction, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(rawTx); err != nil {
+		return nil, fmt.Errorf("decode transaction: %w", err)
+	}
+
+	if err := c.backend.SendTransaction(ctx, tx); err != nil {
+		return nil, fmt.Errorf("send transaction: %w", err)
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"resenje.org/eas"
+	"resenje.org/eas/eastest"
+)
+
+func TestClient_UnsignedTransaction(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, &eastest.Options{Accounts: 2})
+	client := h.Client()
+
+	schema := "string message"
+
+	call, err := client.SchemaRegistry.RegisterCall(schema, common.Address{}, true)
+	assertNilError(t, err)
+	assertEqual(t, "to", call.To, h.Addresses.SchemaRegistry)
+	assertEqual(t, "value", call.Value.Sign(), 0)
+
+	signer := h.Accounts[1]
+	signerAddress := crypto.PubkeyToAddress(signer.PrivateKey.PublicKey)
+
+	schemaUID := sendSigned(t, h, call, signer, func(tx *types.Transaction) eas.UID {
+		r, err := client.SchemaRegistry.WaitRegister(tx)(ctx)
+		assertNilError(t, err)
+		assertEqual(t, "registerer", r.Registerer, signerAddress)
+		return r.UID
+	})
+
+	call, err = client.EAS.AttestCall(schemaUID, &eas.AttestOptions{Revocable: true}, "Hello!")
+	assertNilError(t, err)
+	assertEqual(t, "to", call.To, h.Addresses.EAS)
+
+	uid := sendSigned(t, h, call, signer, func(tx *types.Transaction) eas.UID {
+		r, err := client.EAS.WaitAttest(tx)(ctx)
+		assertNilError(t, err)
+		assertEqual(t, "attester", r.Attester, signerAddress)
+		assertEqual(t, "schema", r.Schema, schemaUID)
+		return r.UID
+	})
+
+	a, err := client.EAS.GetAttestation(ctx, uid)
+	assertNilError(t, err)
+	assertEqual(t, "attester", a.Attester, signerAddress)
+
+	call, err = client.EAS.MultiRevokeCall(schemaUID, []eas.UID{uid})
+	assertNilError(t, err)
+
+	sendSigned(t, h, call, signer, func(tx *types.Transaction) eas.UID {
+		r, err := client.EAS.WaitMultiRevoke(tx)(ctx)
+		assertNilError(t, err)
+		assertEqual(t, "revocations", len(r), 1)
+		assertEqual(t, "attester", r[0].Attester, signerAddress)
+		return r[0].UID
+	})
+}
+
+func TestClient_UnsignedTransaction_revert(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, nil)
+	client := h.Client()
+
+	call, err := client.EAS.AttestCall(eas.UID{1}, nil, "Hello!")
+	assertNilError(t, err)
+
+	_, err = client.UnsignedTransaction(ctx, call, client.Address())
+	assertErrorIs(t, err, eas.ErrInvalidSchema)
+}
+
+func TestClient_SendRawTransaction_invalid(t *testing.T) {
+	client := newClient(t)
+
+	_, err := client.SendRawTransaction(context.Background(), []byte{1, 2, 3})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+// sendSigned creates an unsigned transaction of the call, signs it with the
+// account key outside of the client, sends it as a raw transaction with the
+// client of another account and waits for it to be mined.
+func sendSigned[T any](t *testing.T, h *eastest.Harness, call *eas.Call, account eastest.Account, wait func(*types.Transaction) T) T {
+	t.Helper()
+
+	ctx := context.Background()
+	client := h.Client()
+
+	from := crypto.PubkeyToAddress(account.PrivateKey.PublicKey)
+	unsigned, err := client.UnsignedTransaction(ctx, call, from)
+	assertNilError(t, err)
+
+	chainID, err := client.Backend().ChainID(ctx)
+	assertNilError(t, err)
+
+	signed, err := types.SignTx(unsigned, types.LatestSignerForChainID(chainID), account.PrivateKey)
+	assertNilError(t, err)
+
+	raw, err := signed.MarshalBinary()
+	assertNilError(t, err)
+
+	tx, err := client.SendRawTransaction(ctx, raw)
+	assertNilError(t, err)
+	assertEqual(t, "hash", tx.Hash(), signed.Hash())
+
+	h.Commit()
+
+	return wait(tx)
+}
@@ -11,13 +11,20 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"golang.org/x/time/rate"
+
+	"resenje.org/eas/deployments"
 )
 
 type Backend interface {
@@ -33,19 +40,82 @@ type Client struct {
 	easContractAddress common.Address
 	options            *Options
 
-	chainID *big.Int
+	chainID    *big.Int
+	startBlock uint64
+
+	easVersion            Version
+	schemaRegistryVersion Version
+
+	errorABIs         []*abi.ABI
+	resolverErrorABIs map[common.Address][]*abi.ABI
+	errorABIsMu       sync.RWMutex
+
+	// rpcClient sends JSON-RPC batch requests, if the backend is an
+	// ethclient.Client.
+	rpcClient         *rpc.Client
+	callsLimiter      *rate.Limiter
+	multicallDeployed *bool
+	multicallMu       sync.Mutex
 
 	// Contracts
 	SchemaRegistry *SchemaRegistryContract
 	EAS            *EASContract
+	// EIP712Proxy is set only if its address is provided in options.
+	EIP712Proxy *ProxyContract
+	// Indexer is set only if its address is provided in options.
+	Indexer *IndexerContract
 }
 
 type Options struct {
 	SchemaRegistryContractAddress common.Address
+	EIP712ProxyContractAddress    common.Address
+	IndexerContractAddress        common.Address
 	GasLimit                      uint64
 	GasFeeCap                     *big.Int
 	GasTipCap                     *big.Int
 	Backend                       Backend
+	// StartBlock is used as the first block by EAS contract Filter methods
+	// when zero start block is provided. If not set, the start block of the known
+	// deployment is used, if EAS contract address matches it.
+	StartBlock uint64
+	// FilterChunkSize is the maximal number of blocks in a single logs query
+	// of Filter methods. Queries are split into smaller chunks if the
+	// endpoint rejects them because of too many results. Default is 10000.
+	FilterChunkSize uint64
+	// FilterConcurrency is the number of logs queries of Filter methods that
+	// are made concurrently, while values are still iterated in order.
+	// Default is 1.
+	FilterConcurrency int
+	// WatchPollInterval is the interval of logs queries of Watch methods if
+	// the endpoint does not support subscriptions, and the delay before
+	// resubscribing when a subscription fails. It is also the interval of
+	// logs queries of Stream methods. Default is 5s.
+	WatchPollInterval time.Duration
+	// Simulate executes transactions with eth_call against the pending state
+	// before sending them, and returns decoded errors without sending
+	// transactions that would revert.
+	Simulate bool
+	// ErrorABIs are used to decode errors of all contract calls, in addition
+	// to errors defined by EAS contracts.
+	ErrorABIs []*abi.ABI
+	// Retry enables retries of backend calls that fail with transient errors
+	// for all contracts.
+	Retry *RetryPolicy
+	// RateLimits limit the rate of backend requests for all contracts. Every
+	// retry attempt is counted as a request.
+	RateLimits *RateLimits
+	// SkipVersionCheck disables the detection of EAS, SchemaRegistry and
+	// EIP712Proxy contract versions on client construction, for example for
+	// contracts with custom versions that are compatible with supported ones.
+	// Events are then parsed as events of SchemaRegistry contracts before
+	// version 1.2.
+	SkipVersionCheck bool
+	// MulticallAddress is the address of the Multicall3 contract used by
+	// batch methods. Default is DefaultMulticallAddress.
+	MulticallAddress common.Address
+	// BatchSize is the maximal number of calls in a single multicall or
+	// JSON-RPC batch request of batch methods. Default is 100.
+	BatchSize int
 }
 
 func NewClient(ctx context.Context, endpoint string, pk *ecdsa.PrivateKey, easContractAddress common.Address, o *Options) (*Client, error) {
@@ -58,6 +128,12 @@ func NewClient(ctx context.Context, endpoint string, pk *ecdsa.PrivateKey, easCo
 		o = new(Options)
 	}
 
+	if o.RateLimits != nil {
+		if err := o.RateLimits.validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	backend := o.Backend
 	if backend == nil {
 		b, err := ethclient.DialContext(ctx, endpoint)
@@ -66,6 +142,22 @@ func NewClient(ctx context.Context, endpoint string, pk *ecdsa.PrivateKey, easCo
 		}
 		backend = b
 	}
+	// json-rpc batch requests are sent directly with the rpc client, so they
+	// are used only if the backend is not wrapped, as wrappers such as
+	// FailoverBackend would be bypassed
+	var rpcClient *rpc.Client
+	if b, ok := backend.(*ethclient.Client); ok {
+		rpcClient = b.Client()
+	}
+	callsLimiter := (*RateLimit)(nil).limiter()
+	if o.RateLimits != nil {
+		b := newRateLimitBackend(backend, *o.RateLimits)
+		callsLimiter = b.calls
+		backend = b
+	}
+	if o.Retry != nil {
+		backend = newRetryBackend(backend, *o.Retry)
+	}
 
 	c := &Client{
 		backend:            backend,
@@ -73,6 +165,10 @@ func NewClient(ctx context.Context, endpoint string, pk *ecdsa.PrivateKey, easCo
 		pk:                 pk,
 		easContractAddress: easContractAddress,
 		options:            o,
+		errorABIs:          append([]*abi.ABI(nil), o.ErrorABIs...),
+		resolverErrorABIs:  make(map[common.Address][]*abi.ABI),
+		rpcClient:          rpcClient,
+		callsLimiter:       callsLimiter,
 	}
 
 	chainID, err := c.backend.ChainID(ctx)
@@ -81,6 +177,13 @@ func NewClient(ctx context.Context, endpoint string, pk *ecdsa.PrivateKey, easCo
 	}
 	c.chainID = chainID
 
+	c.startBlock = o.StartBlock
+	if c.startBlock == 0 && chainID.IsUint64() {
+		if d, ok := deployments.ByChainID(chainID.Uint64()); ok && d.EAS == easContractAddress {
+			c.startBlock = d.StartBlock
+		}
+	}
+
 	schemaRegistryContract, err := newSchemaRegistryContract(ctx, c)
 	if err != nil {
 		return nil, fmt.Errorf("construct schema registry contract: %w", err)
@@ -93,9 +196,116 @@ func NewClient(ctx context.Context, endpoint string, pk *ecdsa.PrivateKey, easCo
 	}
 	c.EAS = easContract
 
+	if !o.SkipVersionCheck {
+		if err := c.detectVersions(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if o.EIP712ProxyContractAddress != (common.Address{}) {
+		proxyContract, err := NewProxyContract(ctx, c, o.EIP712ProxyContractAddress)
+		if err != nil {
+			return nil, fmt.Errorf("construct eip712 proxy contract: %w", err)
+		}
+		c.EIP712Proxy = proxyContract
+	}
+
+	if o.IndexerContractAddress != (common.Address{}) {
+		indexerContract, err := NewIndexerContract(c, o.IndexerContractAddress)
+		if err != nil {
+			return nil, fmt.Errorf("construct indexer contract: %w", err)
+		}
+		c.Indexer = indexerContract
+	}
+
 	return c, nil
 }
 
+// NewClientForChain constructs a new Client with the EAS contract address of
+// the known deployment on the chain that the endpoint or the backend from
+// options is connected to.
+func NewClientForChain(ctx context.Context, endpoint string, pk *ecdsa.PrivateKey, o *Options) (*Client, error) {
+	var options Options
+	if o != nil {
+		options = *o
+	}
+
+	if options.Backend == nil {
+		b, err := ethclient.DialContext(ctx, endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("connect to endpoint: %w", err)
+		}
+		options.Backend = b
+	}
+
+	chainID, err := options.Backend.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get chain id: %w", err)
+	}
+
+	if !chainID.IsUint64() {
+		return nil, fmt.Errorf("unknown deployment for chain id %v", chainID)
+	}
+	d, ok := deployments.ByChainID(chainID.Uint64())
+	if !ok {
+		return nil, fmt.Errorf("unknown deployment for chain id %v", chainID)
+	}
+
+	if options.SchemaRegistryContractAddress == (common.Address{}) {
+		options.SchemaRegistryContractAddress = d.SchemaRegistry
+	}
+
+	return NewClient(ctx, endpoint, pk, d.EAS, &options)
+}
+
+// detectVersions gets versions of EAS and SchemaRegistry contracts, checks if
+// they are supported and selects bindings of the versions.
+func (c *Client) detectVersions(ctx context.Context) error {
+	v, err := c.versionOf(ctx, c.easContractAddress, c.EAS.Version)
+	if err != nil {
+		return fmt.Errorf("get eas contract version: %w", err)
+	}
+	c.easVersion, err = checkVersion("eas", v)
+	if err != nil {
+		return err
+	}
+
+	// other contracts with the version function, such as EIP712Proxy, do not
+	// have the schema registry
+	registry, err := c.EAS.contract.GetSchemaRegistry(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("not an eas contract: get schema registry: %w", c.EAS.unpackError(err))
+	}
+	if registry == (common.Address{}) {
+		return errors.New("not an eas contract: zero schema registry address")
+	}
+
+	v, err = c.versionOf(ctx, c.SchemaRegistry.address, c.SchemaRegistry.Version)
+	if err != nil {
+		return fmt.Errorf("get schema registry contract version: %w", err)
+	}
+	c.schemaRegistryVersion, err = checkVersion("schema registry", v)
+	if err != nil {
+		return err
+	}
+	c.SchemaRegistry.setVersion(c.schemaRegistryVersion)
+
+	return nil
+}
+
+// EASVersion returns the version of the EAS contract detected on client
+// construction. It is zero if the version check is skipped in options.
+func (c *Client) EASVersion() Version {
+	return c.easVersion
+}
+
+// SchemaRegistryVersion returns the version of the SchemaRegistry contract
+// detected on client construction. It is zero if the version check is skipped
+// in options.
+func (c *Client) SchemaRegistryVersion() Version {
+	return c.schemaRegistryVersion
+}
+
 func (c *Client) Backend() Backend {
 	return c.backend
 }
@@ -104,6 +314,45 @@ func (c *Client) Address() common.Address {
 	return c.account
 }
 
+// RegisterErrorABI adds the ABI with errors that are used to decode reverts
+// of all contract calls.
+func (c *Client) RegisterErrorABI(a *abi.ABI) {
+	c.errorABIsMu.Lock()
+	defer c.errorABIsMu.Unlock()
+
+	c.errorABIs = append(c.errorABIs, a)
+}
+
+// RegisterResolverErrorABI adds the ABI with errors that are used to decode
+// reverts of attestations and revocations with schemas that use the resolver
+// contract on the provided address.
+func (c *Client) RegisterResolverErrorABI(resolver common.Address, a *abi.ABI) {
+	c.errorABIsMu.Lock()
+	defer c.errorABIsMu.Unlock()
+
+	c.resolverErrorABIs[resolver] = append(c.resolverErrorABIs[resolver], a)
+}
+
+// errorABIsFor returns ABIs to decode errors with the provided contract ABI
+// first, followed by ABIs of the resolver and globally registered ones.
+func (c *Client) errorABIsFor(contract *abi.ABI, resolver common.Address) []*abi.ABI {
+	c.errorABIsMu.RLock()
+	defer c.errorABIsMu.RUnlock()
+
+	abis := make([]*abi.ABI, 0, 1+len(c.resolverErrorABIs[resolver])+len(c.errorABIs))
+	abis = append(abis, contract)
+	abis = append(abis, c.resolverErrorABIs[resolver]...)
+	abis = append(abis, c.errorABIs...)
+	return abis
+}
+
+func (c *Client) hasResolverErrorABIs() bool {
+	c.errorABIsMu.RLock()
+	defer c.errorABIsMu.RUnlock()
+
+	return len(c.resolverErrorABIs) > 0
+}
+
 func Ptr[T any](v T) *T {
 	return &v
 }
@@ -128,39 +377,70 @@ func (c *Client) newTxOpts(ctx context.Context) (*bind.TransactOpts, error) {
 	return auth, nil
 }
 
+// simulate executes the contract method with the client account as the
+// sender and the provided value against the pending state, without sending a
+// transaction, and returns unpacked method outputs.
+func (c *Client) simulate(ctx context.Context, contract common.Address, a *abi.ABI, value *big.Int, method string, args ...any) ([]any, error) {
+	msg, err := c.newCallMsg(contract, a, value, method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var output []byte
+	if pending, ok := c.backend.(bind.PendingContractCaller); ok {
+		output, err = pending.PendingCallContract(ctx, msg)
+	} else {
+		output, err = c.backend.CallContract(ctx, msg, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return a.Unpack(method, output)
+}
+
+func (c *Client) filterStart(start uint64) uint64 {
+	if start == 0 {
+		return c.startBlock
+	}
+	return start
+}
+
 type WaitTx[T any] func(ctx context.Context) (*T, error)
 
-func newWaitTx[T any](tx *types.Transaction, client *Client, parse func(log types.Log) (*T, error)) WaitTx[T] {
+func newWaitTx[T any](tx *types.Transaction, client *Client, contract common.Address, event abi.Event, parse func(log types.Log) (*T, error)) WaitTx[T] {
 	return func(ctx context.Context) (*T, error) {
 		receipt, err := bind.WaitMined(ctx, client.backend, tx)
 		if err != nil {
 			return nil, err
 		}
 
-		l := len(receipt.Logs)
+		logs := eventLogs(receipt, contract, event)
+		l := len(logs)
 		if l == 0 {
-			return nil, fmt.Errorf("transaction %s without logs", tx.Hash())
+			return nil, fmt.Errorf("transaction %s without %s logs", tx.Hash(), event.Name)
 		}
 		if l > 1 {
-			return nil, fmt.Errorf("transaction %s without multiple logs %v", tx.Hash(), l)
+			return nil, fmt.Errorf("transaction %s with multiple %s logs %v", tx.Hash(), event.Name, l)
 		}
 
-		return parse(*receipt.Logs[0])
+		return parse(logs[0])
 	}
 }
 
 type WaitTxMulti[T any] func(ctx context.Context) ([]T, error)
 
-func newWaitTxMulti[T any](tx *types.Transaction, client *Client, parse func(log types.Log) (*T, error)) WaitTxMulti[T] {
+func newWaitTxMulti[T any](tx *types.Transaction, client *Client, contract common.Address, event abi.Event, parse func(log types.Log) (*T, error)) WaitTxMulti[T] {
 	return func(ctx context.Context) ([]T, error) {
 		receipt, err := bind.WaitMined(ctx, client.backend, tx)
 		if err != nil {
 			return nil, err
 		}
 
-		s := make([]T, 0, len(receipt.Logs))
-		for i, l := range receipt.Logs {
-			v, err := parse(*l)
+		logs := eventLogs(receipt, contract, event)
+		s := make([]T, 0, len(logs))
+		for i, l := range logs {
+			v, err := parse(l)
 			if err != nil {
 				return nil, fmt.Errorf("parse log %v: %w", i, err)
 			}
@@ -171,6 +451,20 @@ func newWaitTxMulti[T any](tx *types.Transaction, client *Client, parse func(log
 	}
 }
 
+// eventLogs returns receipt logs of the event emitted by the contract,
+// skipping logs of other contracts, such as multisig wallets or schema
+// resolvers.
+func eventLogs(receipt *types.Receipt, contract common.Address, event abi.Event) []types.Log {
+	var logs []types.Log
+	for _, l := range receipt.Logs {
+		if l.Address != contract || len(l.Topics) == 0 || l.Topics[0] != event.ID {
+			continue
+		}
+		logs = append(logs, *l)
+	}
+	return logs
+}
+
 type Iterator[T any] interface {
 	Value() T
 	Close() error
@@ -178,28 +472,6 @@ type Iterator[T any] interface {
 	Next() bool
 }
 
-func newChanProxy[I, O any](ctx context.Context, sink chan<- O, constructor func(I) O) chan I {
-	proxy := make(chan I)
-	go func() {
-		for {
-			select {
-			case v, ok := <-proxy:
-				if !ok {
-					return
-				}
-				select {
-				case sink <- constructor(v):
-				case <-ctx.Done():
-					return
-				}
-			case <-ctx.Done():
-				return
-			}
-		}
-	}()
-	return proxy
-}
-
 func newParseProxy[I, O any](parse func(log types.Log) (I, error), constructor func(I) O) func(log types.Log) (O, error) {
 	return func(log types.Log) (O, error) {
 		r, err := parse(log)
@@ -211,6 +483,14 @@ func newParseProxy[I, O any](parse func(log types.Log) (I, error), constructor f
 	}
 }
 
+func toUIDSlice(s [][32]byte) []UID {
+	r := make([]UID, 0, len(s))
+	for _, u := range s {
+		r = append(r, u)
+	}
+	return r
+}
+
 func castUIDSlice(s []UID) [][32]byte {
 	r := make([][32]byte, 0, len(s))
 	for _, u := range s {
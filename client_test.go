@@ -7,10 +7,11 @@ package eas_test
 
 import (
 	"context"
-	"math/big"
+	"fmt"
 	"reflect"
 	"testing"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient/simulated"
@@ -23,47 +24,120 @@ type Client struct {
 	*eas.Client
 	account common.Address
 	backend *simulated.Backend
+
+	easAddress common.Address
 }
 
 func newClient(t testing.TB) *Client {
 	t.Helper()
 
+	h := eastest.New(t, nil)
+
+	return &Client{
+		Client:     h.Client(),
+		account:    h.Client().Address(),
+		backend:    h.Simulated,
+		easAddress: h.Addresses.EAS,
+	}
+}
+
+func TestClient_Address(t *testing.T) {
+	c := newClient(t)
+
+	var zeroAddress common.Address
+	if c.account == zeroAddress {
+		t.Error("zero address account")
+	}
+
+	assertEqual(t, "address", c.account, c.Address())
+}
+
+func TestNewClientForChain_unknown(t *testing.T) {
+	client := newClient(t)
 	ctx := context.Background()
 
 	privateKey, err := crypto.GenerateKey()
 	assertNilError(t, err)
 
-	balance := new(big.Int)
-	balance.SetString("100000000000000000000", 10)
+	_, err = eas.NewClientForChain(ctx, "", privateKey, &eas.Options{
+		Backend: client.backend.Client(),
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	assertEqual(t, "error", err.Error(), "unknown deployment for chain id 1337")
+}
 
-	accountAddress := crypto.PubkeyToAddress(privateKey.PublicKey)
+func TestClient_startBlock(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
 
-	sim, easAddress := eastest.NewSimulatedBackend(t, map[common.Address]*big.Int{
-		accountAddress: balance,
-	})
+	schemaUID := registerSchema(t, client, "string message")
+
+	attest(t, client, schemaUID, nil, "one")
+	attest(t, client, schemaUID, nil, "two")
+
+	blockNumber, err := client.Backend().(ethereum.BlockNumberReader).BlockNumber(ctx)
+	assertNilError(t, err)
+
+	want := attest(t, client, schemaUID, nil, "three")
 
-	// construct client
-	c, err := eas.NewClient(ctx, "", privateKey, easAddress, &eas.Options{
-		Backend: sim.Client(),
+	privateKey, err := crypto.GenerateKey()
+	assertNilError(t, err)
+
+	c, err := eas.NewClient(ctx, "", privateKey, client.easAddress, &eas.Options{
+		Backend:    client.backend.Client(),
+		StartBlock: blockNumber + 1,
 	})
 	assertNilError(t, err)
 
-	return &Client{
-		Client:  c,
-		account: accountAddress,
-		backend: sim,
+	it, err := c.EAS.FilterAttested(ctx, 0, nil, nil, nil, nil)
+	assertNilError(t, err)
+	defer it.Close()
+
+	var got []eas.UID
+	for it.Next() {
+		got = append(got, it.Value().UID)
 	}
+	assertNilError(t, it.Error())
+
+	assertEqual(t, "uids", got, []eas.UID{want})
 }
 
-func TestClient_Address(t *testing.T) {
-	c := newClient(t)
+func TestClient_simulate(t *testing.T) {
+	ctx := context.Background()
 
-	var zeroAddress common.Address
-	if c.account == zeroAddress {
-		t.Error("zero address account")
+	h := eastest.New(t, &eastest.Options{Faults: true})
+
+	for _, tc := range []struct {
+		simulate bool
+		sent     int
+	}{
+		{simulate: false, sent: 1},
+		{simulate: true, sent: 0},
+	} {
+		t.Run(fmt.Sprintf("simulate %v", tc.simulate), func(t *testing.T) {
+			c, err := eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EAS, &eas.Options{
+				Backend: h.Backend(),
+				// with a fixed gas limit, the transaction is not estimated
+				GasLimit: 1_000_000,
+				Simulate: tc.simulate,
+			})
+			assertNilError(t, err)
+
+			h.Faults.Reset()
+
+			_, _, err = c.EAS.Attest(ctx, eas.UID{1}, nil, "Hello!")
+			if tc.simulate {
+				assertErrorIs(t, err, eas.ErrInvalidSchema)
+			} else {
+				assertNilError(t, err)
+			}
+			assertEqual(t, "sent transactions", h.Faults.Calls("SendTransaction"), tc.sent)
+
+			h.Commit()
+		})
 	}
-
-	assertEqual(t, "address", c.account, c.Address())
 }
 
 func assertEqual[T any](t testing.TB, name string, got, want T) {
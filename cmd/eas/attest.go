@@ -0,0 +1,224 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"resenje.org/eas"
+)
+
+var attestCommand = command{
+	name:     "attest",
+	args:     "<schema uid>",
+	help:     "Make an attestation with values provided as a JSON object with schema field names as keys.",
+	transact: true,
+	flags: func(fs *flag.FlagSet) func(ctx context.Context, e *env, args []string) error {
+		data := fs.String("data", "", "attestation values as a JSON object")
+		dataFile := fs.String("data-file", "", "path to the file with attestation values as a JSON object, - for standard input")
+		recipient := fs.String("recipient", "", "recipient address")
+		expiration := fs.String("expiration", "", "expiration time in RFC3339 format")
+		revocable := fs.Bool("revocable", true, "make the attestation revocable")
+		refUID := fs.String("ref", "", "referenced attestation uid")
+		value := fs.String("value", "", "value in wei sent to the schema resolver")
+		wait := fs.Bool("wait", true, "wait for the transaction to be mined")
+
+		return func(ctx context.Context, e *env, args []string) error {
+			if len(args) != 1 {
+				return errors.New("schema uid argument is required")
+			}
+			schemaUID, err := parseUID(args[0])
+			if err != nil {
+				return err
+			}
+
+			o := &eas.AttestOptions{
+				Revocable: *revocable,
+			}
+			if *recipient != "" {
+				a, err := parseAddress(*recipient)
+				if err != nil {
+					return err
+				}
+				o.Recipient = a
+			}
+			if *expiration != "" {
+				t, err := time.Parse(time.RFC3339, *expiration)
+				if err != nil {
+					return fmt.Errorf("invalid expiration time: %w", err)
+				}
+				o.ExpirationTime = t
+			}
+			if *refUID != "" {
+				u, err := parseUID(*refUID)
+				if err != nil {
+					return err
+				}
+				o.RefUID = u
+			}
+			o.Value, err = parseValue(*value)
+			if err != nil {
+				return err
+			}
+
+			values, err := readValues(e.stdin, *data, *dataFile)
+			if err != nil {
+				return err
+			}
+
+			schema, err := e.client.SchemaRegistry.GetSchema(ctx, schemaUID)
+			if err != nil {
+				return fmt.Errorf("get schema: %w", err)
+			}
+			if schema.UID.IsZero() {
+				return fmt.Errorf("schema %s not found", schemaUID)
+			}
+
+			encoded, err := eas.EncodeSchemaValues(schema.Schema, values)
+			if err != nil {
+				return fmt.Errorf("encode values: %w", err)
+			}
+
+			tx, waitTx, err := e.client.EAS.Attest(ctx, schemaUID, o, eas.EncodedData(encoded))
+			if err != nil {
+				return err
+			}
+			if !*wait {
+				return printJSON(e.stdout, transactionOutput{Transaction: tx.Hash()})
+			}
+
+			r, err := waitTx(ctx)
+			if err != nil {
+				return fmt.Errorf("wait transaction %s: %w", tx.Hash(), err)
+			}
+
+			return printJSON(e.stdout, attestedOutput{
+				logOutput: newLogOutput(r.Raw),
+				UID:       r.UID,
+				Schema:    r.Schema,
+				Recipient: r.Recipient,
+				Attester:  r.Attester,
+			})
+		}
+	},
+}
+
+var getAttestationCommand = command{
+	name: "get-attestation",
+	args: "<attestation uid>",
+	help: "Get the attestation and decode its data according to its schema.",
+	flags: func(fs *flag.FlagSet) func(ctx context.Context, e *env, args []string) error {
+		decode := fs.Bool("decode", true, "decode attestation data according to the schema")
+
+		return func(ctx context.Context, e *env, args []string) error {
+			if len(args) != 1 {
+				return errors.New("attestation uid argument is required")
+			}
+			uid, err := parseUID(args[0])
+			if err != nil {
+				return err
+			}
+
+			a, err := e.client.EAS.GetAttestation(ctx, uid)
+			if err != nil {
+				return err
+			}
+			if a.UID.IsZero() {
+				return fmt.Errorf("attestation %s not found", uid)
+			}
+
+			o := attestationOutput{
+				UID:            a.UID,
+				Schema:         a.Schema,
+				Time:           a.Time.UTC(),
+				ExpirationTime: optionalTime(a.ExpirationTime),
+				RevocationTime: optionalTime(a.RevocationTime),
+				RefUID:         a.RefUID,
+				Recipient:      a.Recipient,
+				Attester:       a.Attester,
+				Revocable:      a.Revocable,
+				Revoked:        a.IsRevoked(),
+				Data:           "0x" + hex.EncodeToString(a.Data),
+			}
+
+			if *decode {
+				schema, err := e.client.SchemaRegistry.GetSchema(ctx, a.Schema)
+				if err != nil {
+					return fmt.Errorf("get schema: %w", err)
+				}
+				o.Values, err = eas.DecodeSchemaValues(schema.Schema, a.Data)
+				if err != nil {
+					return fmt.Errorf("decode values: %w", err)
+				}
+			}
+
+			return printJSON(e.stdout, o)
+		}
+	},
+}
+
+type attestationOutput struct {
+	UID            eas.UID           `json:"uid"`
+	Schema         eas.UID           `json:"schema"`
+	Time           time.Time         `json:"time"`
+	ExpirationTime *time.Time        `json:"expirationTime,omitempty"`
+	RevocationTime *time.Time        `json:"revocationTime,omitempty"`
+	RefUID         eas.UID           `json:"refUID"`
+	Recipient      common.Address    `json:"recipient"`
+	Attester       common.Address    `json:"attester"`
+	Revocable      bool              `json:"revocable"`
+	Revoked        bool              `json:"revoked"`
+	Data           string            `json:"data"`
+	Values         []eas.SchemaValue `json:"values,omitempty"`
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.Unix() == 0 {
+		return nil
+	}
+	t = t.UTC()
+	return &t
+}
+
+func readValues(stdin io.Reader, data, filename string) (map[string]any, error) {
+	var r io.Reader
+	switch {
+	case data != "" && filename != "":
+		return nil, errors.New("only one of data and data-file flags can be set")
+	case data != "":
+		r = bytes.NewReader([]byte(data))
+	case filename == "-":
+		r = stdin
+	case filename != "":
+		f, err := os.Open(filename)
+		if err != nil {
+			return nil, fmt.Errorf("open data file: %w", err)
+		}
+		defer f.Close()
+		r = f
+	default:
+		return nil, errors.New("data or data-file flag is required")
+	}
+
+	var values map[string]any
+	d := json.NewDecoder(r)
+	d.UseNumber()
+	if err := d.Decode(&values); err != nil {
+		return nil, fmt.Errorf("decode values: %w", err)
+	}
+	return values, nil
+}
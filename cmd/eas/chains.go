@@ -0,0 +1,31 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "resenje.org/eas/deployments"
+
+// endpoints are public JSON-RPC endpoints for chains with known deployments.
+var endpoints = map[string]string{
+	deployments.Mainnet.Name:         "https://ethereum-rpc.publicnode.com",
+	deployments.Sepolia.Name:         "https://ethereum-sepolia-rpc.publicnode.com",
+	deployments.Optimism.Name:        "https://optimism-rpc.publicnode.com",
+	deployments.OptimismSepolia.Name: "https://optimism-sepolia-rpc.publicnode.com",
+	deployments.Base.Name:            "https://base-rpc.publicnode.com",
+	deployments.BaseSepolia.Name:     "https://base-sepolia-rpc.publicnode.com",
+	deployments.Arbitrum.Name:        "https://arbitrum-one-rpc.publicnode.com",
+	deployments.Polygon.Name:         "https://polygon-bor-rpc.publicnode.com",
+	deployments.Scroll.Name:          "https://scroll-rpc.publicnode.com",
+	deployments.Linea.Name:           "https://linea-rpc.publicnode.com",
+}
+
+func chainNames() []string {
+	all := deployments.All()
+	names := make([]string, 0, len(all))
+	for _, d := range all {
+		names = append(names, d.Name)
+	}
+	return names
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"resenje.org/eas"
+)
+
+var filterCommand = command{
+	name: "filter",
+	args: "<attested|revoked|timestamped|revoked-offchain|registered>",
+	help: "Filter contract events and write each of them as a JSON object.",
+	flags: func(fs *flag.FlagSet) func(ctx context.Context, e *env, args []string) error {
+		from := fs.Uint64("from", 0, "first block number, start block of the known deployment if not set")
+		to := fs.Uint64("to", 0, "last block number, latest block if not set")
+		var recipients, attesters, schemas, uids listFlag
+		fs.Var(&recipients, "recipient", "recipient or revoker address, can be set multiple times")
+		fs.Var(&attesters, "attester", "attester address, can be set multiple times")
+		fs.Var(&schemas, "schema", "schema uid, can be set multiple times")
+		fs.Var(&uids, "uid", "registered schema uid or timestamped and offchain revoked data, can be set multiple times")
+
+		return func(ctx context.Context, e *env, args []string) error {
+			if len(args) != 1 {
+				return errors.New("event name argument is required")
+			}
+
+			var end *uint64
+			if *to > 0 {
+				end = to
+			}
+
+			recipientAddresses, err := parseAddresses(recipients)
+			if err != nil {
+				return err
+			}
+			attesterAddresses, err := parseAddresses(attesters)
+			if err != nil {
+				return err
+			}
+			schemaUIDs, err := parseUIDs(schemas)
+			if err != nil {
+				return err
+			}
+			dataUIDs, err := parseUIDs(uids)
+			if err != nil {
+				return err
+			}
+
+			switch args[0] {
+			case "attested":
+				it, err := e.client.EAS.FilterAttested(ctx, *from, end, recipientAddresses, attesterAddresses, schemaUIDs)
+				if err != nil {
+					return err
+				}
+				return printEvents(e.stdout, it, func(r eas.EASAttested) any {
+					return attestedOutput{
+						logOutput: newLogOutput(r.Raw),
+						UID:       r.UID,
+						Schema:    r.Schema,
+						Recipient: r.Recipient,
+						Attester:  r.Attester,
+					}
+				})
+			case "revoked":
+				it, err := e.client.EAS.FilterRevoked(ctx, *from, end, recipientAddresses, attesterAddresses, schemaUIDs)
+				if err != nil {
+					return err
+				}
+				return printEvents(e.stdout, it, func(r eas.EASRevoked) any {
+					return revokedOutput{
+						logOutput: newLogOutput(r.Raw),
+						UID:       r.UID,
+						Schema:    r.Schema,
+						Recipient: r.Recipient,
+						Attester:  r.Attester,
+					}
+				})
+			case "timestamped":
+				it, err := e.client.EAS.FilterTimestamped(ctx, *from, end, dataUIDs, nil)
+				if err != nil {
+					return err
+				}
+				return printEvents(e.stdout, it, func(r eas.EASTimestamped) any {
+					return newTimestampedOutput(&r)
+				})
+			case "revoked-offchain":
+				it, err := e.client.EAS.FilterRevokedOffchain(ctx, *from, end, recipientAddresses, dataUIDs, nil)
+				if err != nil {
+					return err
+				}
+				return printEvents(e.stdout, it, func(r eas.EASRevokedOffchain) any {
+					return newRevokedOffchainOutput(&r)
+				})
+			case "registered":
+				it, err := e.client.SchemaRegistry.FilterRegistered(ctx, *from, end, dataUIDs)
+				if err != nil {
+					return err
+				}
+				return printEvents(e.stdout, it, func(r *eas.SchemaRegistryRegistered) any {
+					return registeredOutput{
+						logOutput:  newLogOutput(r.Raw),
+						UID:        r.UID,
+						Registerer: r.Registerer,
+					}
+				})
+			}
+			return fmt.Errorf("unknown event %q", args[0])
+		}
+	},
+}
+
+func printEvents[T any](w io.Writer, it eas.Iterator[T], output func(T) any) error {
+	defer it.Close()
+
+	for it.Next() {
+		if err := printJSON(w, output(it.Value())); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+func parseAddresses(s []string) ([]common.Address, error) {
+	addresses := make([]common.Address, 0, len(s))
+	for _, v := range s {
+		a, err := parseAddress(v)
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, a)
+	}
+	return addresses, nil
+}
+
+type logOutput struct {
+	BlockNumber     uint64      `json:"blockNumber"`
+	BlockHash       common.Hash `json:"blockHash"`
+	TransactionHash common.Hash `json:"transactionHash"`
+	LogIndex        uint        `json:"logIndex"`
+}
+
+func newLogOutput(l types.Log) logOutput {
+	return logOutput{
+		BlockNumber:     l.BlockNumber,
+		BlockHash:       l.BlockHash,
+		TransactionHash: l.TxHash,
+		LogIndex:        l.Index,
+	}
+}
+
+type attestedOutput struct {
+	logOutput
+	UID       eas.UID        `json:"uid"`
+	Schema    eas.UID        `json:"schema"`
+	Recipient common.Address `json:"recipient"`
+	Attester  common.Address `json:"attester"`
+}
+
+type revokedOutput struct {
+	logOutput
+	UID       eas.UID        `json:"uid"`
+	Schema    eas.UID        `json:"schema"`
+	Recipient common.Address `json:"recipient"`
+	Attester  common.Address `json:"attester"`
+}
+
+type timestampedOutput struct {
+	logOutput
+	Data      eas.UID   `json:"data"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+func newTimestampedOutput(r *eas.EASTimestamped) timestampedOutput {
+	return timestampedOutput{
+		logOutput: newLogOutput(r.Raw),
+		Data:      r.Data,
+		Timestamp: r.Timestamp.Time().UTC(),
+	}
+}
+
+type revokedOffchainOutput struct {
+	logOutput
+	Revoker   common.Address `json:"revoker"`
+	Data      eas.UID        `json:"data"`
+	Timestamp time.Time      `json:"timestamp"`
+}
+
+func newRevokedOffchainOutput(r *eas.EASRevokedOffchain) revokedOffchainOutput {
+	return revokedOffchainOutput{
+		logOutput: newLogOutput(r.Raw),
+		Revoker:   r.Revoker,
+		Data:      r.Data,
+		Timestamp: r.Timestamp.UTC(),
+	}
+}
+
+type registeredOutput struct {
+	logOutput
+	UID        eas.UID        `json:"uid"`
+	Registerer common.Address `json:"registerer"`
+}
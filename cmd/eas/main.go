@@ -0,0 +1,325 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command eas is a command line tool for everyday Ethereum Attestation
+// Service operations, such as registering schemas, making, revoking and
+// inspecting attestations and filtering contract events.
+//
+// All results are written to the standard output as JSON.
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"resenje.org/eas"
+	"resenje.org/eas/deployments"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	a := &app{
+		stdin:  os.Stdin,
+		stdout: os.Stdout,
+		stderr: os.Stderr,
+		getenv: os.Getenv,
+	}
+
+	if err := a.run(ctx, os.Args[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return
+		}
+		fmt.Fprintln(os.Stderr, "eas:", err)
+		stop()
+		os.Exit(1)
+	}
+}
+
+const passwordEnv = "EAS_KEYSTORE_PASSWORD"
+
+type app struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+	getenv func(string) string
+
+	// backend is used instead of dialing the endpoint if set.
+	backend eas.Backend
+}
+
+type command struct {
+	name     string
+	args     string
+	help     string
+	transact bool
+	flags    func(fs *flag.FlagSet) func(ctx context.Context, e *env, args []string) error
+}
+
+type env struct {
+	client *eas.Client
+	stdin  io.Reader
+	stdout io.Writer
+}
+
+var commands = []command{
+	registerSchemaCommand,
+	getSchemaCommand,
+	attestCommand,
+	getAttestationCommand,
+	revokeCommand,
+	timestampCommand,
+	revokeOffchainCommand,
+	filterCommand,
+}
+
+func (a *app) run(ctx context.Context, args []string) error {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "-help" || args[0] == "--help" {
+		a.usage()
+		if len(args) == 0 {
+			return errors.New("missing command")
+		}
+		return nil
+	}
+
+	for _, cmd := range commands {
+		if cmd.name != args[0] {
+			continue
+		}
+		return a.runCommand(ctx, cmd, args[1:])
+	}
+
+	a.usage()
+	return fmt.Errorf("unknown command %q", args[0])
+}
+
+func (a *app) usage() {
+	fmt.Fprintln(a.stderr, "Usage: eas <command> [flags] [arguments]")
+	fmt.Fprintln(a.stderr)
+	fmt.Fprintln(a.stderr, "Commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(a.stderr, "  %-18s %s\n", cmd.name, cmd.help)
+	}
+	fmt.Fprintln(a.stderr)
+	fmt.Fprintln(a.stderr, "Run 'eas <command> -h' for command flags.")
+}
+
+type globalOptions struct {
+	chain        string
+	endpoint     string
+	contract     string
+	keystore     string
+	passwordFile string
+	timeout      time.Duration
+}
+
+func (a *app) runCommand(ctx context.Context, cmd command, args []string) error {
+	fs := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+
+	var o globalOptions
+	fs.StringVar(&o.chain, "chain", "", fmt.Sprintf("known chain name, one of: %s", strings.Join(chainNames(), ", ")))
+	fs.StringVar(&o.endpoint, "endpoint", "", "JSON-RPC endpoint, overrides the chain default")
+	fs.StringVar(&o.contract, "contract", "", "EAS contract address, overrides the chain default")
+	fs.StringVar(&o.keystore, "keystore", "", "path to the Ethereum keystore file used for signing transactions")
+	fs.StringVar(&o.passwordFile, "password-file", "", "path to the file with keystore password, "+passwordEnv+" environment variable is used if not set")
+	fs.DurationVar(&o.timeout, "timeout", 5*time.Minute, "maximal duration of the command")
+
+	exec := cmd.flags(fs)
+
+	fs.Usage = func() {
+		fmt.Fprintf(a.stderr, "Usage: eas %s [flags] %s\n\n%s\n\nFlags:\n", cmd.name, cmd.args, cmd.help)
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if o.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.timeout)
+		defer cancel()
+	}
+
+	client, err := a.newClient(ctx, o, cmd.transact)
+	if err != nil {
+		return err
+	}
+
+	return exec(ctx, &env{
+		client: client,
+		stdin:  a.stdin,
+		stdout: a.stdout,
+	}, fs.Args())
+}
+
+func (a *app) newClient(ctx context.Context, o globalOptions, transact bool) (*eas.Client, error) {
+	endpoint := o.endpoint
+	var contractAddress, schemaRegistryAddress common.Address
+	var chainID *big.Int
+
+	if o.chain != "" {
+		d, ok := deployments.ByName(o.chain)
+		if !ok {
+			return nil, fmt.Errorf("unknown chain %q", o.chain)
+		}
+		if endpoint == "" {
+			endpoint = endpoints[d.Name]
+		}
+		contractAddress = d.EAS
+		schemaRegistryAddress = d.SchemaRegistry
+		chainID = new(big.Int).SetUint64(d.ChainID)
+	}
+
+	if o.contract != "" {
+		if !common.IsHexAddress(o.contract) {
+			return nil, fmt.Errorf("invalid contract address %q", o.contract)
+		}
+		contractAddress = common.HexToAddress(o.contract)
+		schemaRegistryAddress = common.Address{}
+	}
+
+	if endpoint == "" && a.backend == nil {
+		return nil, errors.New("endpoint or chain is required")
+	}
+	if contractAddress == (common.Address{}) {
+		return nil, errors.New("contract address or chain is required")
+	}
+
+	var pk *ecdsa.PrivateKey
+	switch {
+	case o.keystore != "":
+		password, err := a.password(o.passwordFile)
+		if err != nil {
+			return nil, err
+		}
+		k, err := eas.LoadEthereumKeyFile(os.DirFS(filepath.Dir(o.keystore)), filepath.Base(o.keystore), password)
+		if err != nil {
+			return nil, fmt.Errorf("load keystore: %w", err)
+		}
+		pk = k
+	case transact:
+		return nil, errors.New("keystore is required for sending transactions")
+	default:
+		// A random key is sufficient for read-only operations.
+		k, err := crypto.GenerateKey()
+		if err != nil {
+			return nil, fmt.Errorf("generate key: %w", err)
+		}
+		pk = k
+	}
+
+	client, err := eas.NewClient(ctx, endpoint, pk, contractAddress, &eas.Options{
+		SchemaRegistryContractAddress: schemaRegistryAddress,
+		Backend:                       a.backend,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("construct client: %w", err)
+	}
+
+	if chainID != nil {
+		id, err := client.Backend().ChainID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get chain id: %w", err)
+		}
+		if id.Cmp(chainID) != 0 {
+			return nil, fmt.Errorf("endpoint chain id %v does not match chain %s id %v", id, o.chain, chainID)
+		}
+	}
+
+	return client, nil
+}
+
+func (a *app) password(filename string) (string, error) {
+	if filename != "" {
+		b, err := os.ReadFile(filename)
+		if err != nil {
+			return "", fmt.Errorf("read password file: %w", err)
+		}
+		return strings.TrimRight(string(b), "\r\n"), nil
+	}
+	if p := a.getenv(passwordEnv); p != "" {
+		return p, nil
+	}
+	return "", fmt.Errorf("keystore password is required, set %s environment variable or password-file flag", passwordEnv)
+}
+
+func printJSON(w io.Writer, v any) error {
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	return e.Encode(v)
+}
+
+type transactionOutput struct {
+	Transaction common.Hash `json:"transaction"`
+}
+
+func parseUID(s string) (eas.UID, error) {
+	var uid eas.UID
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil || len(b) != len(uid) {
+		return uid, fmt.Errorf("invalid uid %q", s)
+	}
+	copy(uid[:], b)
+	return uid, nil
+}
+
+func parseUIDs(s []string) ([]eas.UID, error) {
+	uids := make([]eas.UID, 0, len(s))
+	for _, v := range s {
+		uid, err := parseUID(v)
+		if err != nil {
+			return nil, err
+		}
+		uids = append(uids, uid)
+	}
+	return uids, nil
+}
+
+func parseAddress(s string) (common.Address, error) {
+	if !common.IsHexAddress(s) {
+		return common.Address{}, fmt.Errorf("invalid address %q", s)
+	}
+	return common.HexToAddress(s), nil
+}
+
+func parseValue(s string) (*big.Int, error) {
+	if s == "" {
+		return nil, nil
+	}
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok || v.Sign() < 0 {
+		return nil, fmt.Errorf("invalid value %q", s)
+	}
+	return v, nil
+}
+
+type listFlag []string
+
+func (f *listFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *listFlag) Set(v string) error {
+	*f = append(*f, v)
+	return nil
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"resenje.org/eas"
+	"resenje.org/eas/eastest"
+)
+
+const (
+	testKeystore         = "../../testdata/UTC--2024-03-16T20-25-58.090Z--0x08752c431c3e38b12e94a0f195b166590e764831"
+	testKeystorePassword = "12345"
+)
+
+var testAccount = common.HexToAddress("0x08752c431c3e38b12e94a0f195b166590e764831")
+
+type testApp struct {
+	*app
+	contract string
+}
+
+func newTestApp(t *testing.T) *testApp {
+	t.Helper()
+
+	balance := new(big.Int)
+	balance.SetString("100000000000000000000", 10)
+
+	h := eastest.New(t, &eastest.Options{
+		Alloc: map[common.Address]*big.Int{
+			testAccount: balance,
+		},
+		AutoCommit: true,
+	})
+
+	return &testApp{
+		app: &app{
+			stdin:  strings.NewReader(""),
+			stderr: new(bytes.Buffer),
+			getenv: func(key string) string {
+				if key == passwordEnv {
+					return testKeystorePassword
+				}
+				return ""
+			},
+			backend: h.Backend(),
+		},
+		contract: h.Addresses.EAS.Hex(),
+	}
+}
+
+func (a *testApp) run(t *testing.T, v any, args ...string) {
+	t.Helper()
+
+	stdout := new(bytes.Buffer)
+	a.stdout = stdout
+
+	args = append([]string{args[0], "-contract", a.contract, "-keystore", testKeystore}, args[1:]...)
+	if err := a.app.run(context.Background(), args); err != nil {
+		t.Fatalf("run %v: %v", args, err)
+	}
+
+	if v == nil {
+		return
+	}
+	if err := json.NewDecoder(stdout).Decode(v); err != nil {
+		t.Fatalf("decode output of %v: %v", args, err)
+	}
+}
+
+func TestCommands(t *testing.T) {
+	a := newTestApp(t)
+
+	schema := "string message, uint64 count, address[] owners"
+
+	var registered registeredOutput
+	a.run(t, &registered, "register-schema", schema)
+	assertEqual(t, "registerer", registered.Registerer, testAccount)
+
+	var s schemaOutput
+	a.run(t, &s, "get-schema", registered.UID.String())
+	assertEqual(t, "schema", s, schemaOutput{
+		UID:       registered.UID,
+		Schema:    schema,
+		Revocable: true,
+	})
+
+	var attested attestedOutput
+	a.run(t, &attested, "attest", "-data", `{"message": "Hello!", "count": 42, "owners": ["0x08752c431c3e38b12e94a0f195b166590e764831"]}`, "-recipient", "0x0000000000000000000000000000000000000001", registered.UID.String())
+	assertEqual(t, "attester", attested.Attester, testAccount)
+	assertEqual(t, "recipient", attested.Recipient, common.Address{19: 1})
+	assertEqual(t, "schema", attested.Schema, registered.UID)
+
+	var attestation struct {
+		attestationOutput
+		Values []struct {
+			Name  string `json:"name"`
+			Type  string `json:"type"`
+			Value any    `json:"value"`
+		} `json:"values"`
+	}
+	a.run(t, &attestation, "get-attestation", attested.UID.String())
+	assertEqual(t, "uid", attestation.UID, attested.UID)
+	assertEqual(t, "revoked", attestation.Revoked, false)
+	assertEqual(t, "revocation time", attestation.RevocationTime, (*time.Time)(nil))
+	assertEqual(t, "values", len(attestation.Values), 3)
+	assertEqual(t, "message", attestation.Values[0].Value, any("Hello!"))
+	assertEqual(t, "count", attestation.Values[1].Value, any(float64(42)))
+	assertEqual(t, "owners", attestation.Values[2].Value, any([]any{testAccount.Hex()}))
+
+	var revoked revokedOutput
+	a.run(t, &revoked, "revoke", registered.UID.String(), attested.UID.String())
+	assertEqual(t, "uid", revoked.UID, attested.UID)
+
+	a.run(t, &attestation, "get-attestation", attested.UID.String())
+	assertEqual(t, "revoked", attestation.Revoked, true)
+	if attestation.RevocationTime == nil {
+		t.Error("revocation time not set")
+	}
+
+	var timestamped []timestampedOutput
+	a.run(t, &timestamped, "timestamp", eas.UID{1}.String(), eas.UID{2}.String())
+	assertEqual(t, "timestamped", len(timestamped), 2)
+	assertEqual(t, "data", timestamped[1].Data, eas.UID{2})
+
+	var revokedOffchain []revokedOffchainOutput
+	a.run(t, &revokedOffchain, "revoke-offchain", eas.UID{3}.String())
+	assertEqual(t, "revoked offchain", len(revokedOffchain), 1)
+	assertEqual(t, "revoker", revokedOffchain[0].Revoker, testAccount)
+
+	var filtered attestedOutput
+	a.run(t, &filtered, "filter", "-schema", registered.UID.String(), "attested")
+	assertEqual(t, "filtered", filtered, attested)
+}
+
+func TestCommands_errors(t *testing.T) {
+	a := newTestApp(t)
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		name string
+		args []string
+	}{
+		{
+			name: "unknown command",
+			args: []string{"unknown"},
+		},
+		{
+			name: "unknown chain",
+			args: []string{"get-schema", "-chain", "unknown", eas.UID{}.String()},
+		},
+		{
+			name: "missing keystore",
+			args: []string{"timestamp", "-contract", a.contract, eas.UID{}.String()},
+		},
+		{
+			name: "invalid uid",
+			args: []string{"get-schema", "-contract", a.contract, "0x1234"},
+		},
+		{
+			name: "invalid schema",
+			args: []string{"register-schema", "-contract", a.contract, "-keystore", testKeystore, "string"},
+		},
+		{
+			name: "schema not found",
+			args: []string{"get-schema", "-contract", a.contract, eas.UID{1}.String()},
+		},
+		{
+			name: "unknown event",
+			args: []string{"filter", "-contract", a.contract, "unknown"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a.stdout = new(bytes.Buffer)
+			if err := a.app.run(ctx, tc.args); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func assertEqual[T any](t testing.TB, name string, got, want T) {
+	t.Helper()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %s %+v, want %+v", name, got, want)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+
+	"resenje.org/eas"
+)
+
+var revokeCommand = command{
+	name:     "revoke",
+	args:     "<schema uid> <attestation uid>",
+	help:     "Revoke an attestation.",
+	transact: true,
+	flags: func(fs *flag.FlagSet) func(ctx context.Context, e *env, args []string) error {
+		value := fs.String("value", "", "value in wei sent to the schema resolver")
+		wait := fs.Bool("wait", true, "wait for the transaction to be mined")
+
+		return func(ctx context.Context, e *env, args []string) error {
+			if len(args) != 2 {
+				return errors.New("schema uid and attestation uid arguments are required")
+			}
+			uids, err := parseUIDs(args)
+			if err != nil {
+				return err
+			}
+			v, err := parseValue(*value)
+			if err != nil {
+				return err
+			}
+
+			tx, waitTx, err := e.client.EAS.Revoke(ctx, uids[0], uids[1], &eas.RevokeOptions{Value: v})
+			if err != nil {
+				return err
+			}
+			if !*wait {
+				return printJSON(e.stdout, transactionOutput{Transaction: tx.Hash()})
+			}
+
+			r, err := waitTx(ctx)
+			if err != nil {
+				return fmt.Errorf("wait transaction %s: %w", tx.Hash(), err)
+			}
+
+			return printJSON(e.stdout, revokedOutput{
+				logOutput: newLogOutput(r.Raw),
+				UID:       r.UID,
+				Schema:    r.Schema,
+				Recipient: r.Recipient,
+				Attester:  r.Attester,
+			})
+		}
+	},
+}
+
+var revokeOffchainCommand = command{
+	name:     "revoke-offchain",
+	args:     "<uid>...",
+	help:     "Revoke one or more offchain attestations.",
+	transact: true,
+	flags: func(fs *flag.FlagSet) func(ctx context.Context, e *env, args []string) error {
+		wait := fs.Bool("wait", true, "wait for the transaction to be mined")
+
+		return func(ctx context.Context, e *env, args []string) error {
+			if len(args) == 0 {
+				return errors.New("at least one uid argument is required")
+			}
+			uids, err := parseUIDs(args)
+			if err != nil {
+				return err
+			}
+
+			tx, waitTx, err := e.client.EAS.MultiRevokeOffchain(ctx, eas.UID{}, uids)
+			if err != nil {
+				return err
+			}
+			if !*wait {
+				return printJSON(e.stdout, transactionOutput{Transaction: tx.Hash()})
+			}
+
+			rs, err := waitTx(ctx)
+			if err != nil {
+				return fmt.Errorf("wait transaction %s: %w", tx.Hash(), err)
+			}
+
+			output := make([]revokedOffchainOutput, 0, len(rs))
+			for _, r := range rs {
+				output = append(output, newRevokedOffchainOutput(&r))
+			}
+			return printJSON(e.stdout, output)
+		}
+	},
+}
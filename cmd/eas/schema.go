@@ -0,0 +1,106 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"resenje.org/eas"
+)
+
+var registerSchemaCommand = command{
+	name:     "register-schema",
+	args:     "<schema>",
+	help:     "Register a new schema, for example \"uint256 id, string name\".",
+	transact: true,
+	flags: func(fs *flag.FlagSet) func(ctx context.Context, e *env, args []string) error {
+		resolver := fs.String("resolver", "", "schema resolver contract address")
+		revocable := fs.Bool("revocable", true, "allow attestations of the schema to be revoked")
+		wait := fs.Bool("wait", true, "wait for the transaction to be mined")
+
+		return func(ctx context.Context, e *env, args []string) error {
+			if len(args) != 1 {
+				return errors.New("schema argument is required")
+			}
+			schema := args[0]
+			if _, err := eas.ParseSchema(schema); err != nil {
+				return fmt.Errorf("invalid schema: %w", err)
+			}
+
+			var resolverAddress common.Address
+			if *resolver != "" {
+				a, err := parseAddress(*resolver)
+				if err != nil {
+					return err
+				}
+				resolverAddress = a
+			}
+
+			tx, waitTx, err := e.client.SchemaRegistry.Register(ctx, schema, resolverAddress, *revocable)
+			if err != nil {
+				return err
+			}
+			if !*wait {
+				return printJSON(e.stdout, transactionOutput{Transaction: tx.Hash()})
+			}
+
+			r, err := waitTx(ctx)
+			if err != nil {
+				return fmt.Errorf("wait transaction %s: %w", tx.Hash(), err)
+			}
+
+			return printJSON(e.stdout, registeredOutput{
+				logOutput:  newLogOutput(r.Raw),
+				UID:        r.UID,
+				Registerer: r.Registerer,
+			})
+		}
+	},
+}
+
+var getSchemaCommand = command{
+	name: "get-schema",
+	args: "<schema uid>",
+	help: "Get the registered schema record.",
+	flags: func(fs *flag.FlagSet) func(ctx context.Context, e *env, args []string) error {
+		return func(ctx context.Context, e *env, args []string) error {
+			if len(args) != 1 {
+				return errors.New("schema uid argument is required")
+			}
+			uid, err := parseUID(args[0])
+			if err != nil {
+				return err
+			}
+
+			s, err := e.client.SchemaRegistry.GetSchema(ctx, uid)
+			if err != nil {
+				return err
+			}
+			if s.UID.IsZero() {
+				return fmt.Errorf("schema %s not found", uid)
+			}
+
+			return printJSON(e.stdout, schemaOutput{
+				UID:       s.UID,
+				Schema:    s.Schema,
+				Resolver:  s.Resolver,
+				Revocable: s.Revocable,
+			})
+		}
+	},
+}
+
+type schemaOutput struct {
+	UID       eas.UID        `json:"uid"`
+	Schema    string         `json:"schema"`
+	Resolver  common.Address `json:"resolver"`
+	Revocable bool           `json:"revocable"`
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+)
+
+var timestampCommand = command{
+	name:     "timestamp",
+	args:     "<data>...",
+	help:     "Timestamp one or more 32 byte hex encoded data values.",
+	transact: true,
+	flags: func(fs *flag.FlagSet) func(ctx context.Context, e *env, args []string) error {
+		wait := fs.Bool("wait", true, "wait for the transaction to be mined")
+
+		return func(ctx context.Context, e *env, args []string) error {
+			if len(args) == 0 {
+				return errors.New("at least one data argument is required")
+			}
+			data, err := parseUIDs(args)
+			if err != nil {
+				return err
+			}
+
+			tx, waitTx, err := e.client.EAS.MultiTimestamp(ctx, data)
+			if err != nil {
+				return err
+			}
+			if !*wait {
+				return printJSON(e.stdout, transactionOutput{Transaction: tx.Hash()})
+			}
+
+			rs, err := waitTx(ctx)
+			if err != nil {
+				return fmt.Errorf("wait transaction %s: %w", tx.Hash(), err)
+			}
+
+			output := make([]timestampedOutput, 0, len(rs))
+			for _, r := range rs {
+				output = append(output, newTimestampedOutput(&r))
+			}
+			return printJSON(e.stdout, output)
+		}
+	},
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Checkpoint is the position of the next event that is not processed.
+type Checkpoint struct {
+	BlockNumber uint64 `json:"blockNumber"`
+	LogIndex    uint   `json:"logIndex"`
+}
+
+func (c Checkpoint) less(o Checkpoint) bool {
+	if c.BlockNumber != o.BlockNumber {
+		return c.BlockNumber < o.BlockNumber
+	}
+	return c.LogIndex < o.LogIndex
+}
+
+// CheckpointStore persists the checkpoint of a consumer.
+type CheckpointStore interface {
+	// Load returns the saved checkpoint, or nil if there is none.
+	Load(ctx context.Context) (*Checkpoint, error)
+	Save(ctx context.Context, c Checkpoint) error
+}
+
+// MemoryCheckpointStore keeps the checkpoint in memory.
+type MemoryCheckpointStore struct {
+	checkpoint *Checkpoint
+	mu         sync.Mutex
+}
+
+// NewMemoryCheckpointStore returns a new empty in-memory checkpoint store.
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return new(MemoryCheckpointStore)
+}
+
+func (s *MemoryCheckpointStore) Load(_ context.Context) (*Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.checkpoint == nil {
+		return nil, nil
+	}
+	c := *s.checkpoint
+	return &c, nil
+}
+
+func (s *MemoryCheckpointStore) Save(_ context.Context, c Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.checkpoint = &c
+	return nil
+}
+
+// FileCheckpointStore keeps the checkpoint in a JSON encoded file. The file is
+// replaced atomically on every save.
+type FileCheckpointStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileCheckpointStore returns a checkpoint store that keeps the checkpoint
+// in the file at the path. The directory of the file must exist.
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{
+		path: path,
+	}
+}
+
+func (s *FileCheckpointStore) Load(_ context.Context) (*Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read checkpoint file: %w", err)
+	}
+
+	var c Checkpoint
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("decode checkpoint file: %w", err)
+	}
+	return &c, nil
+}
+
+func (s *FileCheckpointStore) Save(_ context.Context, c Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("encode checkpoint: %w", err)
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("create checkpoint file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return fmt.Errorf("write checkpoint file: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("sync checkpoint file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("close checkpoint file: %w", err)
+	}
+	if err := os.Rename(f.Name(), s.path); err != nil {
+		return fmt.Errorf("rename checkpoint file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,278 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package consumer provides durable processing of EAS and SchemaRegistry
+// events that resumes from a persisted checkpoint.
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"resenje.org/eas"
+)
+
+// Handler processes a single event. Events are delivered at least once, so
+// handlers should be idempotent. Removed events are delivered for previously
+// delivered events whose blocks are replaced by a reorg.
+type Handler func(ctx context.Context, e eas.StreamEvent[eas.Event]) error
+
+// Options configure the Consumer.
+type Options struct {
+	// Filter selects events, all events are delivered if nil.
+	Filter *eas.EventFilter
+	// Start is the first block of delivered events if there is no saved
+	// checkpoint.
+	Start uint64
+	// Confirmations is the number of blocks that have to be added after the
+	// block of the event before the event is delivered.
+	Confirmations uint64
+	// ReorgDepth is the number of latest blocks that are checked for reorgs.
+	// Default is 64.
+	ReorgDepth uint64
+	// Concurrency is the maximal number of events that are handled at the
+	// same time. Removed events are handled only when no other events are
+	// handled. Default is 1.
+	Concurrency int
+	// MaxAttempts is the maximal number of attempts of the handler for a
+	// single event, before the consumer stops with the handler error.
+	// Default is 5.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry of the handler.
+	// Default is 100ms.
+	InitialBackoff time.Duration
+	// MaxBackoff limits the delay between handler attempts. Default is 30s.
+	MaxBackoff time.Duration
+}
+
+// Consumer delivers events to the handler and saves the checkpoint after the
+// event and all events before it are handled.
+type Consumer struct {
+	client  *eas.Client
+	store   CheckpointStore
+	handler Handler
+	options Options
+}
+
+// New returns a new Consumer of events of the client.
+func New(client *eas.Client, store CheckpointStore, handler Handler, o *Options) *Consumer {
+	if o == nil {
+		o = new(Options)
+	}
+	options := *o
+	if options.Concurrency <= 0 {
+		options.Concurrency = 1
+	}
+	if options.MaxAttempts <= 0 {
+		options.MaxAttempts = 5
+	}
+	if options.InitialBackoff <= 0 {
+		options.InitialBackoff = 100 * time.Millisecond
+	}
+	if options.MaxBackoff <= 0 {
+		options.MaxBackoff = 30 * time.Second
+	}
+	return &Consumer{
+		client:  client,
+		store:   store,
+		handler: handler,
+		options: options,
+	}
+}
+
+// job is a dispatched event with the checkpoint that is saved when the job
+// and all jobs before it are done.
+type job struct {
+	event      eas.StreamEvent[eas.Event]
+	checkpoint Checkpoint
+	done       bool
+	err        error
+}
+
+// Run delivers events from the saved checkpoint until the context is done,
+// the handler fails for an event after all attempts, or the events stream
+// fails. It returns nil if the context is done.
+func (c *Consumer) Run(ctx context.Context) error {
+	saved, err := c.store.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("load checkpoint: %w", err)
+	}
+	cursor := Checkpoint{BlockNumber: c.options.Start}
+	if saved != nil {
+		cursor = *saved
+	}
+	// events before the loaded checkpoint are already processed, unless
+	// they are removed by a reorg
+	processed := cursor
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sink := make(chan eas.StreamEvent[eas.Event])
+	start := cursor.BlockNumber
+	sub, err := c.client.StreamEvents(ctx, &eas.StreamOptions{
+		Start:         &start,
+		Confirmations: c.options.Confirmations,
+		ReorgDepth:    c.options.ReorgDepth,
+	}, sink, c.options.Filter)
+	if err != nil {
+		return fmt.Errorf("stream events: %w", err)
+	}
+	defer sub.Unsubscribe()
+
+	results := make(chan *job)
+	var (
+		pending  []*job // dispatched jobs in the order of events
+		inFlight int
+		barrier  bool // a removed event is handled
+		held     *eas.StreamEvent[eas.Event]
+		runErr   error
+	)
+
+	dispatch := func(e eas.StreamEvent[eas.Event]) {
+		j := &job{event: e, checkpoint: cursor}
+		pending = append(pending, j)
+		inFlight++
+		if e.Type == eas.StreamEventRemoved {
+			barrier = true
+		}
+		go func() {
+			j.err = c.handle(ctx, e)
+			results <- j
+		}()
+	}
+
+	for {
+		stopping := runErr != nil || ctx.Err() != nil
+		if stopping && inFlight == 0 {
+			break
+		}
+
+		if !stopping && held != nil && inFlight == 0 {
+			dispatch(*held)
+			held = nil
+		}
+
+		// only results of handled events are received while stopping
+		var (
+			in      <-chan eas.StreamEvent[eas.Event]
+			subErr  <-chan error
+			ctxDone <-chan struct{}
+		)
+		if !stopping {
+			subErr = sub.Err()
+			ctxDone = ctx.Done()
+			if held == nil && !barrier && inFlight < c.options.Concurrency {
+				in = sink
+			}
+		}
+
+		select {
+		case e := <-in:
+			position := Checkpoint{BlockNumber: e.Log.BlockNumber, LogIndex: e.Log.Index}
+			switch e.Type {
+			case eas.StreamEventAdded:
+				// the event is processed before the loaded checkpoint
+				if position.less(processed) {
+					continue
+				}
+				cursor = Checkpoint{BlockNumber: position.BlockNumber, LogIndex: position.LogIndex + 1}
+			case eas.StreamEventRemoved:
+				// events that replace the removed one have to be delivered
+				// even if they are before the loaded checkpoint
+				if position.less(processed) {
+					processed = position
+				}
+				cursor = position
+			}
+			if e.Type == eas.StreamEventRemoved && inFlight > 0 {
+				held = &e
+				continue
+			}
+			dispatch(e)
+
+		case j := <-results:
+			inFlight--
+			j.done = true
+			if j.event.Type == eas.StreamEventRemoved {
+				barrier = false
+			}
+			if j.err != nil {
+				if runErr == nil && ctx.Err() == nil {
+					runErr = j.err
+				}
+				cancel()
+			}
+			if err := c.commit(ctx, &pending); err != nil && runErr == nil {
+				runErr = err
+				cancel()
+			}
+
+		case err := <-subErr:
+			if err != nil && runErr == nil {
+				runErr = fmt.Errorf("stream events: %w", err)
+			}
+			cancel()
+
+		case <-ctxDone:
+		}
+	}
+
+	return runErr
+}
+
+// commit removes successfully done jobs from the start of pending jobs and
+// saves the checkpoint of the last one.
+func (c *Consumer) commit(ctx context.Context, pending *[]*job) error {
+	var last *job
+	for len(*pending) > 0 {
+		j := (*pending)[0]
+		if !j.done || j.err != nil {
+			break
+		}
+		last = j
+		*pending = (*pending)[1:]
+	}
+	if last == nil {
+		return nil
+	}
+	// the checkpoint of handled events is saved even if the consumer is
+	// stopped
+	if err := c.store.Save(context.WithoutCancel(ctx), last.checkpoint); err != nil {
+		return fmt.Errorf("save checkpoint: %w", err)
+	}
+	return nil
+}
+
+// handle calls the handler until it succeeds, the maximal number of attempts
+// is reached or the context is done.
+func (c *Consumer) handle(ctx context.Context, e eas.StreamEvent[eas.Event]) error {
+	backoff := c.options.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		err := c.handler(ctx, e)
+		if err == nil {
+			return nil
+		}
+		if attempt >= c.options.MaxAttempts || !waitBackoff(ctx, backoff) {
+			return fmt.Errorf("handle %s %s event in block %v at log index %v: %w", e.Type, e.Value.Type, e.Log.BlockNumber, e.Log.Index, err)
+		}
+		backoff = min(2*backoff, c.options.MaxBackoff)
+	}
+}
+
+// waitBackoff waits for the duration and returns false if the context is done
+// before that.
+func waitBackoff(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
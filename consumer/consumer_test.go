@@ -0,0 +1,373 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package consumer_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"resenje.org/eas"
+	"resenje.org/eas/consumer"
+	"resenje.org/eas/eastest"
+)
+
+func TestConsumer(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, &eastest.Options{AutoCommit: true})
+
+	c, err := eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EAS, &eas.Options{
+		Backend:           h.Backend(),
+		WatchPollInterval: 10 * time.Millisecond,
+	})
+	assertNilError(t, err)
+
+	_, wait, err := c.SchemaRegistry.Register(ctx, "string message", [20]byte{}, true)
+	assertNilError(t, err)
+	r, err := wait(ctx)
+	assertNilError(t, err)
+	schemaUID := r.UID
+
+	attest := func(t *testing.T) eas.UID {
+		t.Helper()
+
+		_, wait, err := c.EAS.Attest(ctx, schemaUID, nil, "Hello!")
+		assertNilError(t, err)
+		r, err := wait(ctx)
+		assertNilError(t, err)
+		return r.UID
+	}
+
+	// run starts the consumer and returns a function that stops it and
+	// returns its error
+	run := func(t *testing.T, cons *consumer.Consumer) (stop func() error) {
+		t.Helper()
+
+		ctx, cancel := context.WithCancel(ctx)
+		errC := make(chan error, 1)
+		go func() {
+			errC <- cons.Run(ctx)
+		}()
+		return func() error {
+			cancel()
+			select {
+			case err := <-errC:
+				return err
+			case <-time.After(5 * time.Second):
+				t.Fatal("timeout")
+			}
+			return nil
+		}
+	}
+
+	type recorder struct {
+		uids []eas.UID
+		last consumer.Checkpoint
+		mu   sync.Mutex
+	}
+
+	handler := func(r *recorder, fail func(eas.UID) error) consumer.Handler {
+		return func(_ context.Context, e eas.StreamEvent[eas.Event]) error {
+			uid := e.Value.Attested.UID
+			if fail != nil {
+				if err := fail(uid); err != nil {
+					return err
+				}
+			}
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			r.uids = append(r.uids, uid)
+			if p := (consumer.Checkpoint{BlockNumber: e.Log.BlockNumber, LogIndex: e.Log.Index + 1}); r.last.BlockNumber < p.BlockNumber || r.last.BlockNumber == p.BlockNumber && r.last.LogIndex < p.LogIndex {
+				r.last = p
+			}
+			return nil
+		}
+	}
+
+	waitUIDs := func(t *testing.T, r *recorder, count int) []eas.UID {
+		t.Helper()
+
+		deadline := time.Now().Add(5 * time.Second)
+		for time.Now().Before(deadline) {
+			r.mu.Lock()
+			uids := append([]eas.UID(nil), r.uids...)
+			r.mu.Unlock()
+			if len(uids) >= count {
+				return uids
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatalf("timeout waiting for %v events", count)
+		return nil
+	}
+
+	filter := &eas.EventFilter{Types: []eas.EventType{eas.EventAttested}}
+
+	store := consumer.NewFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoint.json"))
+
+	first := []eas.UID{attest(t), attest(t), attest(t)}
+
+	t.Run("retry and checkpoint", func(t *testing.T) {
+		var failed sync.Map
+		r := new(recorder)
+		stop := run(t, consumer.New(c, store, handler(r, func(uid eas.UID) error {
+			// fail the first attempt of every event
+			if _, ok := failed.LoadOrStore(uid, true); !ok {
+				return errors.New("temporary")
+			}
+			return nil
+		}), &consumer.Options{
+			Filter:         filter,
+			Concurrency:    2,
+			InitialBackoff: time.Millisecond,
+		}))
+
+		uids := waitUIDs(t, r, len(first))
+		assertNilError(t, stop())
+		assertEqual(t, "uids", sortedUIDs(uids), sortedUIDs(first))
+
+		checkpoint, err := store.Load(ctx)
+		assertNilError(t, err)
+		if checkpoint == nil {
+			t.Fatal("checkpoint not saved")
+		}
+		// the checkpoint is after the last event
+		assertEqual(t, "checkpoint", *checkpoint, r.last)
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		second := attest(t)
+
+		r := new(recorder)
+		stop := run(t, consumer.New(c, store, handler(r, nil), &consumer.Options{
+			Filter: filter,
+		}))
+
+		waitUIDs(t, r, 1)
+		// no other events are delivered
+		time.Sleep(100 * time.Millisecond)
+		assertNilError(t, stop())
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		assertEqual(t, "uids", r.uids, []eas.UID{second})
+	})
+
+	t.Run("handler error", func(t *testing.T) {
+		before, err := store.Load(ctx)
+		assertNilError(t, err)
+
+		third := attest(t)
+
+		errTest := errors.New("test")
+		var attempts int
+		cons := consumer.New(c, store, func(_ context.Context, e eas.StreamEvent[eas.Event]) error {
+			attempts++
+			return errTest
+		}, &consumer.Options{
+			Filter:         filter,
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+		})
+
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+
+		err = cons.Run(ctx)
+		assertErrorIs(t, err, errTest)
+		assertEqual(t, "attempts", attempts, 3)
+
+		after, err := store.Load(ctx)
+		assertNilError(t, err)
+		assertEqual(t, "checkpoint", after, before)
+
+		// the failed event is delivered again
+		r := new(recorder)
+		stop := run(t, consumer.New(c, store, handler(r, nil), &consumer.Options{
+			Filter: filter,
+		}))
+		uids := waitUIDs(t, r, 1)
+		assertNilError(t, stop())
+		assertEqual(t, "uid", uids[0], third)
+	})
+}
+
+func TestConsumer_reorg(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, &eastest.Options{
+		AutoCommit: true,
+		Faults:     true,
+	})
+
+	c, err := eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EAS, &eas.Options{
+		Backend:           h.Backend(),
+		WatchPollInterval: 10 * time.Millisecond,
+	})
+	assertNilError(t, err)
+
+	_, wait, err := c.SchemaRegistry.Register(ctx, "string message", [20]byte{}, true)
+	assertNilError(t, err)
+	r, err := wait(ctx)
+	assertNilError(t, err)
+	schemaUID := r.UID
+
+	type delivery struct {
+		typ    eas.StreamEventType
+		txHash common.Hash
+		block  uint64
+		index  uint
+	}
+	var (
+		deliveries []delivery
+		mu         sync.Mutex
+	)
+	store := consumer.NewMemoryCheckpointStore()
+	cons := consumer.New(c, store, func(_ context.Context, e eas.StreamEvent[eas.Event]) error {
+		mu.Lock()
+		defer mu.Unlock()
+		deliveries = append(deliveries, delivery{typ: e.Type, txHash: e.Log.TxHash, block: e.Log.BlockNumber, index: e.Log.Index})
+		return nil
+	}, &consumer.Options{
+		Filter: &eas.EventFilter{Types: []eas.EventType{eas.EventAttested}},
+	})
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	errC := make(chan error, 1)
+	go func() {
+		errC <- cons.Run(ctx)
+	}()
+
+	waitDeliveries := func(t *testing.T, count int) []delivery {
+		t.Helper()
+
+		deadline := time.Now().Add(5 * time.Second)
+		for time.Now().Before(deadline) {
+			mu.Lock()
+			d := append([]delivery(nil), deliveries...)
+			mu.Unlock()
+			if len(d) >= count {
+				return d
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatalf("timeout waiting for %v events", count)
+		return nil
+	}
+
+	var txHashes []common.Hash
+	for range 2 {
+		_, wait, err := c.EAS.Attest(ctx, schemaUID, nil, "Hello!")
+		assertNilError(t, err)
+		r, err := wait(ctx)
+		assertNilError(t, err)
+		txHashes = append(txHashes, r.Raw.TxHash)
+	}
+	added := waitDeliveries(t, 2)
+
+	// transactions are included in a block that is before blocks of removed
+	// events
+	assertNilError(t, h.Faults.Reorg(ctx, 5))
+
+	d := waitDeliveries(t, 6)
+	cancel()
+	assertNilError(t, <-errC)
+
+	for i, want := range []struct {
+		typ    eas.StreamEventType
+		txHash common.Hash
+	}{
+		{eas.StreamEventAdded, txHashes[0]},
+		{eas.StreamEventAdded, txHashes[1]},
+		{eas.StreamEventRemoved, txHashes[1]},
+		{eas.StreamEventRemoved, txHashes[0]},
+		{eas.StreamEventAdded, txHashes[0]},
+		{eas.StreamEventAdded, txHashes[1]},
+	} {
+		assertEqual(t, "type", d[i].typ, want.typ)
+		assertEqual(t, "tx hash", d[i].txHash, want.txHash)
+	}
+	if d[4].block >= added[0].block {
+		t.Fatalf("got event in block %v, want before block %v", d[4].block, added[0].block)
+	}
+
+	checkpoint, err := store.Load(context.Background())
+	assertNilError(t, err)
+	assertEqual(t, "checkpoint", *checkpoint, consumer.Checkpoint{BlockNumber: d[5].block, LogIndex: d[5].index + 1})
+}
+
+func TestMemoryCheckpointStore(t *testing.T) {
+	testCheckpointStore(t, consumer.NewMemoryCheckpointStore())
+}
+
+func TestFileCheckpointStore(t *testing.T) {
+	testCheckpointStore(t, consumer.NewFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoint.json")))
+}
+
+func testCheckpointStore(t *testing.T, s consumer.CheckpointStore) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	c, err := s.Load(ctx)
+	assertNilError(t, err)
+	if c != nil {
+		t.Fatalf("got checkpoint %+v, want nil", c)
+	}
+
+	for _, want := range []consumer.Checkpoint{
+		{BlockNumber: 10, LogIndex: 2},
+		{BlockNumber: 12, LogIndex: 0},
+	} {
+		assertNilError(t, s.Save(ctx, want))
+
+		c, err := s.Load(ctx)
+		assertNilError(t, err)
+		assertEqual(t, "checkpoint", *c, want)
+	}
+}
+
+func sortedUIDs(uids []eas.UID) []string {
+	s := make([]string, 0, len(uids))
+	for _, uid := range uids {
+		s = append(s, uid.String())
+	}
+	slices.Sort(s)
+	return s
+}
+
+func assertNilError(t testing.TB, err error) {
+	t.Helper()
+
+	if err != nil {
+		t.Fatalf("got error %[1]T %[1]q", err)
+	}
+}
+
+func assertErrorIs(t testing.TB, err, target error) {
+	t.Helper()
+
+	if !errors.Is(err, target) {
+		t.Errorf("got error %v, want %v", err, target)
+	}
+}
+
+func assertEqual[T any](t testing.TB, name string, got, want T) {
+	t.Helper()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %s %+v, want %+v", name, got, want)
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package deploy provides functions to deploy EAS contracts to any EVM
+// compatible blockchain.
+package deploy
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"resenje.org/eas/internal/contracts"
+)
+
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	ethereum.ChainIDReader
+}
+
+// Options configure deployment transactions.
+type Options struct {
+	GasLimit  uint64
+	GasPrice  *big.Int
+	GasFeeCap *big.Int
+	GasTipCap *big.Int
+	// Nonce of the deployment transaction. If not set, the pending nonce of
+	// the account is used.
+	Nonce *uint64
+}
+
+// WaitDeployment waits for the deployment transaction to be mined and returns
+// the address of the deployed contract.
+type WaitDeployment func(ctx context.Context) (common.Address, error)
+
+// SchemaRegistry deploys the SchemaRegistry contract.
+func SchemaRegistry(ctx context.Context, backend Backend, pk *ecdsa.PrivateKey, o *Options) (common.Address, *types.Transaction, WaitDeployment, error) {
+	txOpts, err := newTxOpts(ctx, backend, pk, o)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	address, tx, _, err := contracts.DeploySchemaRegistry(txOpts, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, fmt.Errorf("deploy schema registry: %w", err)
+	}
+
+	return address, tx, newWaitDeployment(backend, tx), nil
+}
+
+// EAS deploys the EAS contract that uses the SchemaRegistry contract on the
+// provided address.
+func EAS(ctx context.Context, backend Backend, pk *ecdsa.PrivateKey, schemaRegistry common.Address, o *Options) (common.Address, *types.Transaction, WaitDeployment, error) {
+	txOpts, err := newTxOpts(ctx, backend, pk, o)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	address, tx, _, err := contracts.DeployEAS(txOpts, backend, schemaRegistry)
+	if err != nil {
+		return common.Address{}, nil, nil, fmt.Errorf("deploy eas: %w", err)
+	}
+
+	return address, tx, newWaitDeployment(backend, tx), nil
+}
+
+// EIP712Proxy deploys the EIP712Proxy contract for the EAS contract on the
+// provided address. The name is used in the EIP712 domain of the proxy.
+func EIP712Proxy(ctx context.Context, backend Backend, pk *ecdsa.PrivateKey, eas common.Address, name string, o *Options) (common.Address, *types.Transaction, WaitDeployment, error) {
+	txOpts, err := newTxOpts(ctx, backend, pk, o)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	address, tx, _, err := contracts.DeployEIP712Proxy(txOpts, backend, eas, name)
+	if err != nil {
+		return common.Address{}, nil, nil, fmt.Errorf("deploy eip712 proxy: %w", err)
+	}
+
+	return address, tx, newWaitDeployment(backend, tx), nil
+}
+
+// Indexer deploys the Indexer contract for the EAS contract on the provided
+// address.
+func Indexer(ctx context.Context, backend Backend, pk *ecdsa.PrivateKey, eas common.Address, o *Options) (common.Address, *types.Transaction, WaitDeployment, error) {
+	txOpts, err := newTxOpts(ctx, backend, pk, o)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	address, tx, _, err := contracts.DeployIndexer(txOpts, backend, eas)
+	if err != nil {
+		return common.Address{}, nil, nil, fmt.Errorf("deploy indexer: %w", err)
+	}
+
+	return address, tx, newWaitDeployment(backend, tx), nil
+}
+
+// Addresses of deployed contracts. EIP712Proxy and Indexer addresses are zero
+// if those contracts are not deployed.
+type Addresses struct {
+	SchemaRegistry common.Address
+	EAS            common.Address
+	EIP712Proxy    common.Address
+	Indexer        common.Address
+}
+
+// ContractsOptions configure the deployment of all contracts.
+type ContractsOptions struct {
+	Options
+	// EIP712ProxyName is the EIP712 domain name of the EIP712Proxy contract,
+	// which is deployed only if the name is set.
+	EIP712ProxyName string
+	// Indexer enables the deployment of the Indexer contract.
+	Indexer bool
+}
+
+// WaitContracts waits for all deployment transactions to be mined and
+// returns the addresses of deployed contracts.
+type WaitContracts func(ctx context.Context) (Addresses, error)
+
+// Contracts deploys the SchemaRegistry and EAS contracts, and optionally the
+// EIP712Proxy and Indexer contracts. Transactions are sent with sequential
+// nonces without waiting for previous ones to be mined.
+func Contracts(ctx context.Context, backend Backend, pk *ecdsa.PrivateKey, o *ContractsOptions) (Addresses, []*types.Transaction, WaitContracts, error) {
+	if o == nil {
+		o = new(ContractsOptions)
+	}
+
+	// the nonce is counted locally, so that the nonce of the caller options
+	// is not changed
+	var nonce uint64
+	if o.Nonce != nil {
+		nonce = *o.Nonce
+	} else {
+		n, err := backend.PendingNonceAt(ctx, crypto.PubkeyToAddress(pk.PublicKey))
+		if err != nil {
+			return Addresses{}, nil, nil, fmt.Errorf("get pending nonce: %w", err)
+		}
+		nonce = n
+	}
+	nextOptions := func() *Options {
+		options := o.Options
+		options.Nonce = new(uint64)
+		*options.Nonce = nonce
+		nonce++
+		return &options
+	}
+
+	var (
+		addresses Addresses
+		txs       []*types.Transaction
+		waits     []WaitDeployment
+	)
+
+	address, tx, wait, err := SchemaRegistry(ctx, backend, pk, nextOptions())
+	if err != nil {
+		return Addresses{}, nil, nil, err
+	}
+	addresses.SchemaRegistry = address
+	txs = append(txs, tx)
+	waits = append(waits, wait)
+
+	address, tx, wait, err = EAS(ctx, backend, pk, addresses.SchemaRegistry, nextOptions())
+	if err != nil {
+		return Addresses{}, nil, nil, err
+	}
+	addresses.EAS = address
+	txs = append(txs, tx)
+	waits = append(waits, wait)
+
+	if o.EIP712ProxyName != "" {
+		address, tx, wait, err = EIP712Proxy(ctx, backend, pk, addresses.EAS, o.EIP712ProxyName, nextOptions())
+		if err != nil {
+			return Addresses{}, nil, nil, err
+		}
+		addresses.EIP712Proxy = address
+		txs = append(txs, tx)
+		waits = append(waits, wait)
+	}
+
+	if o.Indexer {
+		address, tx, wait, err = Indexer(ctx, backend, pk, addresses.EAS, nextOptions())
+		if err != nil {
+			return Addresses{}, nil, nil, err
+		}
+		addresses.Indexer = address
+		txs = append(txs, tx)
+		waits = append(waits, wait)
+	}
+
+	return addresses, txs, func(ctx context.Context) (Addresses, error) {
+		for i, wait := range waits {
+			if _, err := wait(ctx); err != nil {
+				return Addresses{}, fmt.Errorf("wait deployment transaction %s: %w", txs[i].Hash(), err)
+			}
+		}
+		return addresses, nil
+	}, nil
+}
+
+func newWaitDeployment(backend Backend, tx *types.Transaction) WaitDeployment {
+	return func(ctx context.Context) (common.Address, error) {
+		return bind.WaitDeployed(ctx, backend, tx)
+	}
+}
+
+func newTxOpts(ctx context.Context, backend Backend, pk *ecdsa.PrivateKey, o *Options) (*bind.TransactOpts, error) {
+	if o == nil {
+		o = new(Options)
+	}
+
+	chainID, err := backend.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get chain id: %w", err)
+	}
+
+	opts, err := bind.NewKeyedTransactorWithChainID(pk, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("construct transactor: %w", err)
+	}
+
+	publicKeyECDSA, ok := pk.Public().(*ecdsa.PublicKey)
+	if !ok {
+		return nil, errors.New("not a valid ecdsa public key")
+	}
+
+	nonce := o.Nonce
+	if nonce == nil {
+		n, err := backend.PendingNonceAt(ctx, crypto.PubkeyToAddress(*publicKeyECDSA))
+		if err != nil {
+			return nil, fmt.Errorf("get pending nonce: %w", err)
+		}
+		nonce = &n
+	}
+
+	opts.Context = ctx
+	opts.Nonce = new(big.Int).SetUint64(*nonce)
+	opts.Value = big.NewInt(0)
+	opts.GasLimit = o.GasLimit
+	opts.GasPrice = o.GasPrice
+	opts.GasFeeCap = o.GasFeeCap
+	opts.GasTipCap = o.GasTipCap
+
+	return opts, nil
+}
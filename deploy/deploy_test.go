@@ -0,0 +1,180 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package deploy_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	"resenje.org/eas"
+	"resenje.org/eas/deploy"
+	"resenje.org/eas/internal/contracts"
+)
+
+func TestContracts(t *testing.T) {
+	ctx := context.Background()
+
+	sim, privateKey := newSimulatedBackend(t)
+	backend := sim.Client()
+
+	addresses, txs, wait, err := deploy.Contracts(ctx, backend, privateKey, &deploy.ContractsOptions{
+		EIP712ProxyName: "EIP712Proxy",
+		Indexer:         true,
+	})
+	assertNilError(t, err)
+	assertEqual(t, "transactions", len(txs), 4)
+
+	sim.Commit()
+
+	got, err := wait(ctx)
+	assertNilError(t, err)
+	assertEqual(t, "addresses", got, addresses)
+
+	account := crypto.PubkeyToAddress(privateKey.PublicKey)
+	assertEqual(t, "schema registry address", addresses.SchemaRegistry, crypto.CreateAddress(account, 0))
+	assertEqual(t, "eas address", addresses.EAS, crypto.CreateAddress(account, 1))
+	assertEqual(t, "eip712 proxy address", addresses.EIP712Proxy, crypto.CreateAddress(account, 2))
+	assertEqual(t, "indexer address", addresses.Indexer, crypto.CreateAddress(account, 3))
+
+	client, err := eas.NewClient(ctx, "", privateKey, addresses.EAS, &eas.Options{Backend: backend})
+	assertNilError(t, err)
+
+	version, err := client.EAS.Version(ctx)
+	assertNilError(t, err)
+	assertEqual(t, "eas version", version, "1.0.0")
+
+	proxy, err := contracts.NewEIP712Proxy(addresses.EIP712Proxy, backend)
+	assertNilError(t, err)
+
+	proxyEAS, err := proxy.GetEAS(nil)
+	assertNilError(t, err)
+	assertEqual(t, "proxy eas address", proxyEAS, addresses.EAS)
+
+	name, err := proxy.GetName(nil)
+	assertNilError(t, err)
+	assertEqual(t, "proxy name", name, "EIP712Proxy")
+
+	indexer, err := contracts.NewIndexer(addresses.Indexer, backend)
+	assertNilError(t, err)
+
+	indexerEAS, err := indexer.GetEAS(nil)
+	assertNilError(t, err)
+	assertEqual(t, "indexer eas address", indexerEAS, addresses.EAS)
+}
+
+func TestContracts_options(t *testing.T) {
+	ctx := context.Background()
+
+	sim, privateKey := newSimulatedBackend(t)
+	backend := sim.Client()
+
+	gasPrice := big.NewInt(5_000_000_000)
+
+	nonce := eas.Ptr(uint64(0))
+	addresses, txs, wait, err := deploy.Contracts(ctx, backend, privateKey, &deploy.ContractsOptions{
+		Options: deploy.Options{
+			GasLimit: 10_000_000,
+			GasPrice: gasPrice,
+			Nonce:    nonce,
+		},
+	})
+	assertNilError(t, err)
+	// the nonce of the caller is not changed
+	assertEqual(t, "caller nonce", *nonce, uint64(0))
+	assertEqual(t, "transactions", len(txs), 2)
+	assertEqual(t, "eip712 proxy address", addresses.EIP712Proxy, common.Address{})
+	assertEqual(t, "indexer address", addresses.Indexer, common.Address{})
+
+	for i, tx := range txs {
+		assertEqual(t, "nonce", tx.Nonce(), uint64(i))
+		assertEqual(t, "gas", tx.Gas(), uint64(10_000_000))
+		assertEqual(t, "gas price", tx.GasPrice().Cmp(gasPrice), 0)
+		assertEqual(t, "type", tx.Type(), uint8(types.LegacyTxType))
+	}
+
+	sim.Commit()
+
+	_, err = wait(ctx)
+	assertNilError(t, err)
+}
+
+func TestEAS(t *testing.T) {
+	ctx := context.Background()
+
+	sim, privateKey := newSimulatedBackend(t)
+	backend := sim.Client()
+
+	schemaRegistryAddress, _, waitSchemaRegistry, err := deploy.SchemaRegistry(ctx, backend, privateKey, nil)
+	assertNilError(t, err)
+
+	sim.Commit()
+
+	address, err := waitSchemaRegistry(ctx)
+	assertNilError(t, err)
+	assertEqual(t, "schema registry address", address, schemaRegistryAddress)
+
+	easAddress, _, waitEAS, err := deploy.EAS(ctx, backend, privateKey, schemaRegistryAddress, nil)
+	assertNilError(t, err)
+
+	sim.Commit()
+
+	address, err = waitEAS(ctx)
+	assertNilError(t, err)
+	assertEqual(t, "eas address", address, easAddress)
+
+	e, err := contracts.NewEAS(easAddress, backend)
+	assertNilError(t, err)
+
+	registry, err := e.GetSchemaRegistry(nil)
+	assertNilError(t, err)
+	assertEqual(t, "schema registry", registry, schemaRegistryAddress)
+}
+
+func newSimulatedBackend(t testing.TB) (*simulated.Backend, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	privateKey, err := crypto.GenerateKey()
+	assertNilError(t, err)
+
+	balance := new(big.Int)
+	balance.SetString("100000000000000000000", 10)
+
+	sim := simulated.NewBackend(types.GenesisAlloc{
+		crypto.PubkeyToAddress(privateKey.PublicKey): {
+			Balance: balance,
+		},
+	})
+	t.Cleanup(func() {
+		if err := sim.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	return sim, privateKey
+}
+
+func assertNilError(t testing.TB, err error) {
+	t.Helper()
+
+	if err != nil {
+		t.Fatalf("got error %[1]T %[1]q", err)
+	}
+}
+
+func assertEqual[T comparable](t testing.TB, name string, got, want T) {
+	t.Helper()
+
+	if got != want {
+		t.Errorf("got %s %v, want %v", name, got, want)
+	}
+}
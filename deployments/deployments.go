@@ -0,0 +1,166 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package deployments provides addresses of canonical Ethereum Attestation
+// Service contract deployments on supported chains.
+package deployments
+
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Deployment holds information about EAS contracts deployed on a chain.
+type Deployment struct {
+	// Name is a short lowercase chain name.
+	Name string
+	// ChainID is the EIP-155 chain identifier.
+	ChainID uint64
+	// EAS is the address of the EAS contract.
+	EAS common.Address
+	// SchemaRegistry is the address of the SchemaRegistry contract.
+	SchemaRegistry common.Address
+	// Version is the version of the EAS contract at the time of deployment.
+	Version string
+	// StartBlock is the block number from which contract events should be
+	// looked up. Zero means that events are looked up from the genesis
+	// block.
+	StartBlock uint64
+	// GraphQLEndpoint is the URL of the hosted EAS GraphQL indexer API.
+	GraphQLEndpoint string
+}
+
+// Known deployments on supported chains.
+var (
+	Mainnet = Deployment{
+		Name:            "mainnet",
+		ChainID:         1,
+		EAS:             common.HexToAddress("0xA1207F3BBa224E2c9c3c6D5aF63D0eb1582Ce587"),
+		SchemaRegistry:  common.HexToAddress("0xA7b39296258348C78294F95B872b282326A97BDF"),
+		Version:         "0.26",
+		StartBlock:      16756720,
+		GraphQLEndpoint: "https://easscan.org/graphql",
+	}
+	Sepolia = Deployment{
+		Name:            "sepolia",
+		ChainID:         11155111,
+		EAS:             common.HexToAddress("0xC2679fBD37d54388Ce493F1DB75320D236e1815e"),
+		SchemaRegistry:  common.HexToAddress("0x0a7E2Ff54e76B8E6659aedc9103FB21c038050D0"),
+		Version:         "0.26",
+		StartBlock:      2958570,
+		GraphQLEndpoint: "https://sepolia.easscan.org/graphql",
+	}
+	Optimism = Deployment{
+		Name:            "optimism",
+		ChainID:         10,
+		EAS:             common.HexToAddress("0x4200000000000000000000000000000000000021"),
+		SchemaRegistry:  common.HexToAddress("0x4200000000000000000000000000000000000020"),
+		Version:         "1.0.1",
+		StartBlock:      107476600,
+		GraphQLEndpoint: "https://optimism.easscan.org/graphql",
+	}
+	OptimismSepolia = Deployment{
+		Name:            "optimism-sepolia",
+		ChainID:         11155420,
+		EAS:             common.HexToAddress("0x4200000000000000000000000000000000000021"),
+		SchemaRegistry:  common.HexToAddress("0x4200000000000000000000000000000000000020"),
+		Version:         "1.0.2",
+		GraphQLEndpoint: "https://optimism-sepolia.easscan.org/graphql",
+	}
+	Base = Deployment{
+		Name:            "base",
+		ChainID:         8453,
+		EAS:             common.HexToAddress("0x4200000000000000000000000000000000000021"),
+		SchemaRegistry:  common.HexToAddress("0x4200000000000000000000000000000000000020"),
+		Version:         "1.0.1",
+		GraphQLEndpoint: "https://base.easscan.org/graphql",
+	}
+	BaseSepolia = Deployment{
+		Name:            "base-sepolia",
+		ChainID:         84532,
+		EAS:             common.HexToAddress("0x4200000000000000000000000000000000000021"),
+		SchemaRegistry:  common.HexToAddress("0x4200000000000000000000000000000000000020"),
+		Version:         "1.2.0",
+		GraphQLEndpoint: "https://base-sepolia.easscan.org/graphql",
+	}
+	Arbitrum = Deployment{
+		Name:            "arbitrum",
+		ChainID:         42161,
+		EAS:             common.HexToAddress("0xbD75f629A22Dc1ceD33dDA0b68c546A1c035c458"),
+		SchemaRegistry:  common.HexToAddress("0xA310da9c5B885E7fb3fbA9D66E9Ba6Df512b78eB"),
+		Version:         "0.26",
+		StartBlock:      64528380,
+		GraphQLEndpoint: "https://arbitrum.easscan.org/graphql",
+	}
+	Polygon = Deployment{
+		Name:            "polygon",
+		ChainID:         137,
+		EAS:             common.HexToAddress("0x5E634ef5355f45A855d02D66eCD687b1502AF790"),
+		SchemaRegistry:  common.HexToAddress("0x7876EEF51A891E737AF8ba5A5E0f0Fd29073D5a7"),
+		Version:         "1.3.0",
+		StartBlock:      51279760,
+		GraphQLEndpoint: "https://polygon.easscan.org/graphql",
+	}
+	Scroll = Deployment{
+		Name:            "scroll",
+		ChainID:         534352,
+		EAS:             common.HexToAddress("0xC47300428b6AD2c7D03BB76D05A176058b47E6B0"),
+		SchemaRegistry:  common.HexToAddress("0xD2CDF46556543316e7D34e8eDc4624e2bB95e3B6"),
+		Version:         "1.3.0",
+		GraphQLEndpoint: "https://scroll.easscan.org/graphql",
+	}
+	Linea = Deployment{
+		Name:            "linea",
+		ChainID:         59144,
+		EAS:             common.HexToAddress("0xaEF4103A04090071165F78D45D83A0C0782c2B2a"),
+		SchemaRegistry:  common.HexToAddress("0x55D26f9ae0203EF95494AE4C170eD35f4Cf77797"),
+		Version:         "1.2.0",
+		GraphQLEndpoint: "https://linea.easscan.org/graphql",
+	}
+)
+
+var deployments = []Deployment{
+	Mainnet,
+	Sepolia,
+	Optimism,
+	OptimismSepolia,
+	Base,
+	BaseSepolia,
+	Arbitrum,
+	Polygon,
+	Scroll,
+	Linea,
+}
+
+// All returns all known deployments sorted by chain name.
+func All() []Deployment {
+	s := make([]Deployment, len(deployments))
+	copy(s, deployments)
+	sort.Slice(s, func(i, j int) bool {
+		return s[i].Name < s[j].Name
+	})
+	return s
+}
+
+// ByChainID returns the deployment on the chain with the provided chain id.
+func ByChainID(chainID uint64) (Deployment, bool) {
+	for _, d := range deployments {
+		if d.ChainID == chainID {
+			return d, true
+		}
+	}
+	return Deployment{}, false
+}
+
+// ByName returns the deployment on the chain with the provided name.
+func ByName(name string) (Deployment, bool) {
+	for _, d := range deployments {
+		if d.Name == name {
+			return d, true
+		}
+	}
+	return Deployment{}, false
+}
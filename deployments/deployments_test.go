@@ -0,0 +1,85 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package deployments_test
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"resenje.org/eas/deployments"
+)
+
+func TestAll(t *testing.T) {
+	all := deployments.All()
+
+	if !sort.SliceIsSorted(all, func(i, j int) bool { return all[i].Name < all[j].Name }) {
+		t.Error("deployments are not sorted by name")
+	}
+
+	names := make(map[string]struct{})
+	chainIDs := make(map[uint64]struct{})
+	for _, d := range all {
+		if _, ok := names[d.Name]; ok {
+			t.Errorf("duplicate name %s", d.Name)
+		}
+		names[d.Name] = struct{}{}
+
+		if _, ok := chainIDs[d.ChainID]; ok {
+			t.Errorf("duplicate chain id %v", d.ChainID)
+		}
+		chainIDs[d.ChainID] = struct{}{}
+
+		if d.EAS == (common.Address{}) {
+			t.Errorf("%s: zero eas address", d.Name)
+		}
+		if d.SchemaRegistry == (common.Address{}) {
+			t.Errorf("%s: zero schema registry address", d.Name)
+		}
+		if d.Version == "" {
+			t.Errorf("%s: empty version", d.Name)
+		}
+		if d.GraphQLEndpoint == "" {
+			t.Errorf("%s: empty graphql endpoint", d.Name)
+		}
+	}
+
+	// modifying the returned slice must not change the registry
+	all[0].Name = "changed"
+	if deployments.All()[0].Name == "changed" {
+		t.Error("registry modified")
+	}
+}
+
+func TestByChainID(t *testing.T) {
+	d, ok := deployments.ByChainID(11155111)
+	if !ok {
+		t.Fatal("sepolia not found")
+	}
+	if !reflect.DeepEqual(d, deployments.Sepolia) {
+		t.Errorf("got %+v, want %+v", d, deployments.Sepolia)
+	}
+
+	if _, ok := deployments.ByChainID(1337); ok {
+		t.Error("unexpected deployment for chain id 1337")
+	}
+}
+
+func TestByName(t *testing.T) {
+	d, ok := deployments.ByName("base")
+	if !ok {
+		t.Fatal("base not found")
+	}
+	if !reflect.DeepEqual(d, deployments.Base) {
+		t.Errorf("got %+v, want %+v", d, deployments.Base)
+	}
+
+	if _, ok := deployments.ByName("unknown"); ok {
+		t.Error("unexpected deployment for unknown name")
+	}
+}
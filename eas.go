@@ -11,6 +11,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
 	"resenje.org/eas/internal/contracts"
 )
 
@@ -39,7 +40,20 @@ func newEASContract(client *Client) (*EASContract, error) {
 }
 
 func (c *EASContract) unpackError(err error) error {
-	return unpackError(err, c.abi)
+	return unpackError(err, c.client.errorABIsFor(c.abi, common.Address{})...)
+}
+
+// unpackResolverError decodes errors also with ABIs registered for the
+// resolver of the schema, as errors in resolvers are propagated by the EAS
+// contract.
+func (c *EASContract) unpackResolverError(ctx context.Context, err error, schemaUID UID) error {
+	var resolver common.Address
+	if c.client.hasResolverErrorABIs() {
+		if s, schemaErr := c.client.SchemaRegistry.GetSchema(ctx, schemaUID); schemaErr == nil {
+			resolver = s.Resolver
+		}
+	}
+	return unpackError(err, c.client.errorABIsFor(c.abi, resolver)...)
 }
 
 func (c *EASContract) Version(ctx context.Context) (string, error) {
@@ -11,6 +11,7 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -104,54 +105,194 @@ func newAttestationRequestData(data []byte, o *AttestOptions) contracts.Attestat
 	}
 }
 
+func newAttestationRequest(schemaUID UID, o *AttestOptions, values []any) (contracts.AttestationRequest, error) {
+	data, err := encodeAttestationValues(values)
+	if err != nil {
+		return contracts.AttestationRequest{}, fmt.Errorf("encode attestation values: %w", err)
+	}
+
+	return contracts.AttestationRequest{
+		Schema: schemaUID,
+		Data:   newAttestationRequestData(data, o),
+	}, nil
+}
+
+func newMultiAttestationRequests(schemaUID UID, o *AttestOptions, attestations [][]any) ([]contracts.MultiAttestationRequest, *big.Int, error) {
+	value := big.NewInt(0)
+	var data []contracts.AttestationRequestData
+	for _, a := range attestations {
+		d, err := encodeAttestationValues(a)
+		if err != nil {
+			return nil, nil, err
+		}
+		r := newAttestationRequestData(d, o)
+		value.Add(value, r.Value)
+		data = append(data, r)
+	}
+
+	return []contracts.MultiAttestationRequest{
+		{
+			Schema: schemaUID,
+			Data:   data,
+		},
+	}, value, nil
+}
+
 func (c *EASContract) Attest(ctx context.Context, schemaUID UID, o *AttestOptions, values ...any) (*types.Transaction, WaitTx[EASAttested], error) {
+	request, err := newAttestationRequest(schemaUID, o, values)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if c.client.options.Simulate {
+		if _, err := c.simulateAttest(ctx, request); err != nil {
+			return nil, nil, fmt.Errorf("simulate attest: %w", err)
+		}
+	}
+
 	txOpts, err := c.client.newTxOpts(ctx)
 	if err != nil {
 		return nil, nil, fmt.Errorf("construct transaction options: %w", err)
 	}
+	txOpts.Value = request.Data.Value
 
-	data, err := encodeAttestationValues(values)
+	tx, err := c.contract.Attest(txOpts, request)
 	if err != nil {
-		return nil, nil, fmt.Errorf("encode attestation values: %w", err)
+		return nil, nil, fmt.Errorf("call attest contract method: %w", c.unpackResolverError(ctx, err, schemaUID))
 	}
 
-	tx, err := c.contract.Attest(txOpts, contracts.AttestationRequest{
-		Schema: schemaUID,
-		Data:   newAttestationRequestData(data, o),
-	})
+	return tx, c.WaitAttest(tx), nil
+}
+
+// AttestCall returns the call of the attestation that can be signed and sent
+// as a transaction by any account.
+func (c *EASContract) AttestCall(schemaUID UID, o *AttestOptions, values ...any) (*Call, error) {
+	request, err := newAttestationRequest(schemaUID, o, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.client.newCall(c.client.easContractAddress, c.abi, request.Data.Value, "attest", request)
+}
+
+// WaitAttest returns the function that waits for the attestation transaction
+// to be mined, regardless of the account that sent it.
+func (c *EASContract) WaitAttest(tx *types.Transaction) WaitTx[EASAttested] {
+	return newWaitTx(tx, c.client, c.client.easContractAddress, c.abi.Events["Attested"], newParseProxy(c.contract.ParseAttested, newEASAttested))
+}
+
+// SimulateAttest executes the attestation against the pending state without
+// sending a transaction and returns the UID of the attestation that would be
+// made.
+func (c *EASContract) SimulateAttest(ctx context.Context, schemaUID UID, o *AttestOptions, values ...any) (UID, error) {
+	request, err := newAttestationRequest(schemaUID, o, values)
+	if err != nil {
+		return UID{}, err
+	}
+
+	return c.simulateAttest(ctx, request)
+}
+
+func (c *EASContract) simulateAttest(ctx context.Context, request contracts.AttestationRequest) (UID, error) {
+	output, err := c.client.simulate(ctx, c.client.easContractAddress, c.abi, request.Data.Value, "attest", request)
+	if err != nil {
+		return UID{}, c.unpackResolverError(ctx, err, request.Schema)
+	}
+
+	return *abi.ConvertType(output[0], new(UID)).(*UID), nil
+}
+
+// EstimateAttest estimates the gas and fee of the attestation transaction.
+func (c *EASContract) EstimateAttest(ctx context.Context, schemaUID UID, o *AttestOptions, values ...any) (*Estimate, error) {
+	request, err := newAttestationRequest(schemaUID, o, values)
 	if err != nil {
-		return nil, nil, fmt.Errorf("call attest contract method: %w", c.unpackError(err))
+		return nil, err
 	}
 
-	return tx, newWaitTx(tx, c.client, newParseProxy(c.contract.ParseAttested, newEASAttested)), nil
+	e, err := c.client.estimate(ctx, c.client.easContractAddress, c.abi, request.Data.Value, "attest", request)
+	if err != nil {
+		return nil, c.unpackResolverError(ctx, err, schemaUID)
+	}
+	return e, nil
 }
 
 func (c *EASContract) MultiAttest(ctx context.Context, schemaUID UID, o *AttestOptions, attestations ...[]any) (*types.Transaction, WaitTxMulti[EASAttested], error) {
+	requests, value, err := newMultiAttestationRequests(schemaUID, o, attestations)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if c.client.options.Simulate {
+		if _, err := c.simulateMultiAttest(ctx, schemaUID, requests, value); err != nil {
+			return nil, nil, fmt.Errorf("simulate multi attest: %w", err)
+		}
+	}
+
 	txOpts, err := c.client.newTxOpts(ctx)
 	if err != nil {
 		return nil, nil, fmt.Errorf("construct transaction options: %w", err)
 	}
+	txOpts.Value = value
 
-	var data []contracts.AttestationRequestData
-	for _, a := range attestations {
-		d, err := encodeAttestationValues(a)
-		if err != nil {
-			return nil, nil, err
-		}
-		data = append(data, newAttestationRequestData(d, o))
+	tx, err := c.contract.MultiAttest(txOpts, requests)
+	if err != nil {
+		return nil, nil, fmt.Errorf("call multi attest contract method: %w", c.unpackResolverError(ctx, err, schemaUID))
 	}
 
-	tx, err := c.contract.MultiAttest(txOpts, []contracts.MultiAttestationRequest{
-		{
-			Schema: schemaUID,
-			Data:   data,
-		},
-	})
+	return tx, c.WaitMultiAttest(tx), nil
+}
+
+// MultiAttestCall returns the call of multiple attestations that can be
+// signed and sent as a transaction by any account.
+func (c *EASContract) MultiAttestCall(schemaUID UID, o *AttestOptions, attestations ...[]any) (*Call, error) {
+	requests, value, err := newMultiAttestationRequests(schemaUID, o, attestations)
 	if err != nil {
-		return nil, nil, fmt.Errorf("call multi attest contract method: %w", c.unpackError(err))
+		return nil, err
 	}
 
-	return tx, newWaitTxMulti(tx, c.client, newParseProxy(c.contract.ParseAttested, newEASAttested)), nil
+	return c.client.newCall(c.client.easContractAddress, c.abi, value, "multiAttest", requests)
+}
+
+// WaitMultiAttest returns the function that waits for the multiple
+// attestations transaction to be mined, regardless of the account that sent
+// it.
+func (c *EASContract) WaitMultiAttest(tx *types.Transaction) WaitTxMulti[EASAttested] {
+	return newWaitTxMulti(tx, c.client, c.client.easContractAddress, c.abi.Events["Attested"], newParseProxy(c.contract.ParseAttested, newEASAttested))
+}
+
+// SimulateMultiAttest executes attestations against the pending state without
+// sending a transaction and returns UIDs of attestations that would be made.
+func (c *EASContract) SimulateMultiAttest(ctx context.Context, schemaUID UID, o *AttestOptions, attestations ...[]any) ([]UID, error) {
+	requests, value, err := newMultiAttestationRequests(schemaUID, o, attestations)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.simulateMultiAttest(ctx, schemaUID, requests, value)
+}
+
+func (c *EASContract) simulateMultiAttest(ctx context.Context, schemaUID UID, requests []contracts.MultiAttestationRequest, value *big.Int) ([]UID, error) {
+	output, err := c.client.simulate(ctx, c.client.easContractAddress, c.abi, value, "multiAttest", requests)
+	if err != nil {
+		return nil, c.unpackResolverError(ctx, err, schemaUID)
+	}
+
+	return toUIDSlice(*abi.ConvertType(output[0], new([][32]byte)).(*[][32]byte)), nil
+}
+
+// EstimateMultiAttest estimates the gas and fee of the multiple attestations
+// transaction.
+func (c *EASContract) EstimateMultiAttest(ctx context.Context, schemaUID UID, o *AttestOptions, attestations ...[]any) (*Estimate, error) {
+	requests, value, err := newMultiAttestationRequests(schemaUID, o, attestations)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := c.client.estimate(ctx, c.client.easContractAddress, c.abi, value, "multiAttest", requests)
+	if err != nil {
+		return nil, c.unpackResolverError(ctx, err, schemaUID)
+	}
+	return e, nil
 }
 
 func (c *EASContract) GetAttestation(ctx context.Context, uid UID) (*Attestation, error) {
@@ -170,26 +311,43 @@ func (c *EASContract) IsAttestationValid(ctx context.Context, uid UID) (bool, er
 	return yes, nil
 }
 
-type easAttestedIterator struct {
-	contracts.EASAttestedIterator
+// GetAttestations returns attestations with uids in as few requests as
+// possible. Errors of individual calls are set in results.
+func (c *EASContract) GetAttestations(ctx context.Context, uids []UID) ([]BatchResult[*Attestation], error) {
+	return batch(ctx, c.client, c.client.easContractAddress, c.abi, "getAttestation", uidArgs(uids), func(out []any) *Attestation {
+		a := convertValue[contracts.Attestation](out[0])
+		return newAttestation(&a)
+	})
 }
 
-func (i *easAttestedIterator) Value() EASAttested {
-	return *newEASAttested(i.Event)
+// AreAttestationsValid returns the validity of attestations with uids in as
+// few requests as possible. Errors of individual calls are set in results.
+func (c *EASContract) AreAttestationsValid(ctx context.Context, uids []UID) ([]BatchResult[bool], error) {
+	return batch(ctx, c.client, c.client.easContractAddress, c.abi, "isAttestationValid", uidArgs(uids), func(out []any) bool {
+		return convertValue[bool](out[0])
+	})
 }
 
 func (c *EASContract) FilterAttested(ctx context.Context, start uint64, end *uint64, recipient []common.Address, attester []common.Address, schema []UID) (Iterator[EASAttested], error) {
-	it, err := c.contract.FilterAttested(&bind.FilterOpts{Start: start, End: end, Context: ctx}, recipient, attester, castUIDSlice(schema))
-	if err != nil {
-		return nil, c.unpackError(err)
-	}
-	return &easAttestedIterator{*it}, nil
+	return newFilterIterator(ctx, c.client, c.client.easContractAddress, c.abi.Events["Attested"], c.client.filterStart(start), end,
+		[][]any{filterRule(recipient), filterRule(attester), filterRule(castUIDSlice(schema))},
+		func(l types.Log) (EASAttested, error) {
+			e, err := c.contract.ParseAttested(l)
+			if err != nil {
+				return EASAttested{}, err
+			}
+			return *newEASAttested(e), nil
+		})
 }
 
 func (c *EASContract) WatchAttested(ctx context.Context, start *uint64, sink chan<- *EASAttested, recipient []common.Address, attester []common.Address, schema []UID) (event.Subscription, error) {
-	s, err := c.contract.WatchAttested(&bind.WatchOpts{Start: start, Context: ctx}, newChanProxy(ctx, sink, newEASAttested), recipient, attester, castUIDSlice(schema))
-	if err != nil {
-		return nil, c.unpackError(err)
-	}
-	return s, nil
+	return watchLogs(ctx, c.client, c.client.easContractAddress, c.abi.Events["Attested"], start,
+		[][]any{filterRule(recipient), filterRule(attester), filterRule(castUIDSlice(schema))},
+		newParseProxy(c.contract.ParseAttested, newEASAttested), sink)
+}
+
+func (c *EASContract) StreamAttested(ctx context.Context, o *StreamOptions, sink chan<- StreamEvent[*EASAttested], recipient []common.Address, attester []common.Address, schema []UID) (event.Subscription, error) {
+	return streamLogs(ctx, c.client, c.client.easContractAddress, c.abi.Events["Attested"], o,
+		[][]any{filterRule(recipient), filterRule(attester), filterRule(castUIDSlice(schema))},
+		newParseProxy(c.contract.ParseAttested, newEASAttested), sink)
 }
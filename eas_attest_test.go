@@ -249,3 +249,44 @@ func attest(t testing.TB, client *Client, schemaUID eas.UID, o *eas.AttestOption
 
 	return r.UID
 }
+
+func TestEASContract_SimulateAttest(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	schemaUID := registerSchema(t, client, "string message")
+
+	uid, err := client.EAS.SimulateAttest(ctx, schemaUID, &eas.AttestOptions{Revocable: true}, "Hello!")
+	assertNilError(t, err)
+	if uid.IsZero() {
+		t.Error("zero uid")
+	}
+
+	client.backend.Commit()
+
+	a, err := client.EAS.GetAttestation(ctx, uid)
+	assertNilError(t, err)
+	if !a.UID.IsZero() {
+		t.Error("simulated attestation is made")
+	}
+
+	_, err = client.EAS.SimulateAttest(ctx, eas.UID{1}, nil, "Hello!")
+	assertErrorIs(t, err, eas.ErrInvalidSchema)
+}
+
+func TestEASContract_SimulateMultiAttest(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	schemaUID := registerSchema(t, client, "string message")
+
+	uids, err := client.EAS.SimulateMultiAttest(ctx, schemaUID, nil, []any{"one"}, []any{"two"})
+	assertNilError(t, err)
+	assertEqual(t, "uids", len(uids), 2)
+	if uids[0] == uids[1] {
+		t.Error("same uids")
+	}
+
+	_, err = client.EAS.SimulateMultiAttest(ctx, eas.UID{1}, nil, []any{"one"})
+	assertErrorIs(t, err, eas.ErrInvalidSchema)
+}
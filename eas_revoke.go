@@ -10,7 +10,6 @@ import (
 	"fmt"
 	"math/big"
 
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/event"
@@ -53,67 +52,160 @@ func newRevocationRequestData(attestationUID UID, o *RevokeOptions) contracts.Re
 	}
 }
 
+func newMultiRevocationRequests(schemaUID UID, attestationUIDs []UID) []contracts.MultiRevocationRequest {
+	var data []contracts.RevocationRequestData
+	for _, u := range attestationUIDs {
+		data = append(data, newRevocationRequestData(u, nil))
+	}
+
+	return []contracts.MultiRevocationRequest{
+		{
+			Schema: schemaUID,
+			Data:   data,
+		},
+	}
+}
+
 func (c *EASContract) Revoke(ctx context.Context, schemaUID, attestationUID UID, o *RevokeOptions) (*types.Transaction, WaitTx[EASRevoked], error) {
+	request := contracts.RevocationRequest{
+		Schema: schemaUID,
+		Data:   newRevocationRequestData(attestationUID, o),
+	}
+
+	if c.client.options.Simulate {
+		if err := c.simulateRevoke(ctx, request); err != nil {
+			return nil, nil, fmt.Errorf("simulate revoke: %w", err)
+		}
+	}
+
 	txOpts, err := c.client.newTxOpts(ctx)
 	if err != nil {
 		return nil, nil, fmt.Errorf("construct transaction options: %w", err)
 	}
+	txOpts.Value = request.Data.Value
+
+	tx, err := c.contract.Revoke(txOpts, request)
+	if err != nil {
+		return nil, nil, fmt.Errorf("call revoke contract method: %w", c.unpackResolverError(ctx, err, schemaUID))
+	}
+
+	return tx, c.WaitRevoke(tx), nil
+}
+
+// RevokeCall returns the call of the revocation that can be signed and sent as
+// a transaction by any account.
+func (c *EASContract) RevokeCall(schemaUID, attestationUID UID, o *RevokeOptions) (*Call, error) {
+	request := contracts.RevocationRequest{
+		Schema: schemaUID,
+		Data:   newRevocationRequestData(attestationUID, o),
+	}
+
+	return c.client.newCall(c.client.easContractAddress, c.abi, request.Data.Value, "revoke", request)
+}
 
-	tx, err := c.contract.Revoke(txOpts, contracts.RevocationRequest{
+// WaitRevoke returns the function that waits for the revocation transaction to
+// be mined, regardless of the account that sent it.
+func (c *EASContract) WaitRevoke(tx *types.Transaction) WaitTx[EASRevoked] {
+	return newWaitTx(tx, c.client, c.client.easContractAddress, c.abi.Events["Revoked"], newParseProxy(c.contract.ParseRevoked, newEASRevoked))
+}
+
+// SimulateRevoke executes the revocation against the pending state without
+// sending a transaction and returns an error if it would fail.
+func (c *EASContract) SimulateRevoke(ctx context.Context, schemaUID, attestationUID UID, o *RevokeOptions) error {
+	return c.simulateRevoke(ctx, contracts.RevocationRequest{
 		Schema: schemaUID,
 		Data:   newRevocationRequestData(attestationUID, o),
 	})
-	if err != nil {
-		return nil, nil, fmt.Errorf("call revoke contract method: %w", c.unpackError(err))
+}
+
+func (c *EASContract) simulateRevoke(ctx context.Context, request contracts.RevocationRequest) error {
+	if _, err := c.client.simulate(ctx, c.client.easContractAddress, c.abi, request.Data.Value, "revoke", request); err != nil {
+		return c.unpackResolverError(ctx, err, request.Schema)
+	}
+	return nil
+}
+
+// EstimateRevoke estimates the gas and fee of the revocation transaction.
+func (c *EASContract) EstimateRevoke(ctx context.Context, schemaUID, attestationUID UID, o *RevokeOptions) (*Estimate, error) {
+	request := contracts.RevocationRequest{
+		Schema: schemaUID,
+		Data:   newRevocationRequestData(attestationUID, o),
 	}
 
-	return tx, newWaitTx(tx, c.client, newParseProxy(c.contract.ParseRevoked, newEASRevoked)), nil
+	e, err := c.client.estimate(ctx, c.client.easContractAddress, c.abi, request.Data.Value, "revoke", request)
+	if err != nil {
+		return nil, c.unpackResolverError(ctx, err, schemaUID)
+	}
+	return e, nil
 }
 
 func (c *EASContract) MultiRevoke(ctx context.Context, schemaUID UID, attestationUIDs []UID) (*types.Transaction, WaitTxMulti[EASRevoked], error) {
+	requests := newMultiRevocationRequests(schemaUID, attestationUIDs)
+
+	if c.client.options.Simulate {
+		if err := c.simulateMultiRevoke(ctx, requests); err != nil {
+			return nil, nil, fmt.Errorf("simulate multi revoke: %w", err)
+		}
+	}
+
 	txOpts, err := c.client.newTxOpts(ctx)
 	if err != nil {
 		return nil, nil, fmt.Errorf("construct transaction options: %w", err)
 	}
 
-	var data []contracts.RevocationRequestData
-	for _, u := range attestationUIDs {
-		data = append(data, newRevocationRequestData(u, nil))
-	}
-
-	tx, err := c.contract.MultiRevoke(txOpts, []contracts.MultiRevocationRequest{
-		{
-			Schema: schemaUID,
-			Data:   data,
-		},
-	})
+	tx, err := c.contract.MultiRevoke(txOpts, requests)
 	if err != nil {
-		return nil, nil, fmt.Errorf("call multi revoke contract method: %w", c.unpackError(err))
+		return nil, nil, fmt.Errorf("call multi revoke contract method: %w", c.unpackResolverError(ctx, err, schemaUID))
 	}
 
-	return tx, newWaitTxMulti(tx, c.client, newParseProxy(c.contract.ParseRevoked, newEASRevoked)), nil
+	return tx, c.WaitMultiRevoke(tx), nil
 }
 
-type easRevokedIterator struct {
-	contracts.EASRevokedIterator
+// MultiRevokeCall returns the call of multiple revocations that can be signed
+// and sent as a transaction by any account.
+func (c *EASContract) MultiRevokeCall(schemaUID UID, attestationUIDs []UID) (*Call, error) {
+	return c.client.newCall(c.client.easContractAddress, c.abi, nil, "multiRevoke", newMultiRevocationRequests(schemaUID, attestationUIDs))
 }
 
-func (i *easRevokedIterator) Value() EASRevoked {
-	return *newEASRevoked(i.Event)
+// WaitMultiRevoke returns the function that waits for the multiple
+// revocations transaction to be mined, regardless of the account that sent it.
+func (c *EASContract) WaitMultiRevoke(tx *types.Transaction) WaitTxMulti[EASRevoked] {
+	return newWaitTxMulti(tx, c.client, c.client.easContractAddress, c.abi.Events["Revoked"], newParseProxy(c.contract.ParseRevoked, newEASRevoked))
 }
 
-func (c *EASContract) FilterRevoked(ctx context.Context, start uint64, end *uint64, recipient []common.Address, attester []common.Address, schema []UID) (Iterator[EASRevoked], error) {
-	it, err := c.contract.FilterRevoked(&bind.FilterOpts{Start: start, End: end, Context: ctx}, recipient, attester, castUIDSlice(schema))
-	if err != nil {
-		return nil, c.unpackError(err)
+// SimulateMultiRevoke executes revocations against the pending state without
+// sending a transaction and returns an error if they would fail.
+func (c *EASContract) SimulateMultiRevoke(ctx context.Context, schemaUID UID, attestationUIDs []UID) error {
+	return c.simulateMultiRevoke(ctx, newMultiRevocationRequests(schemaUID, attestationUIDs))
+}
+
+func (c *EASContract) simulateMultiRevoke(ctx context.Context, requests []contracts.MultiRevocationRequest) error {
+	if _, err := c.client.simulate(ctx, c.client.easContractAddress, c.abi, nil, "multiRevoke", requests); err != nil {
+		return c.unpackResolverError(ctx, err, requests[0].Schema)
 	}
-	return &easRevokedIterator{*it}, nil
+	return nil
+}
+
+func (c *EASContract) FilterRevoked(ctx context.Context, start uint64, end *uint64, recipient []common.Address, attester []common.Address, schema []UID) (Iterator[EASRevoked], error) {
+	return newFilterIterator(ctx, c.client, c.client.easContractAddress, c.abi.Events["Revoked"], c.client.filterStart(start), end,
+		[][]any{filterRule(recipient), filterRule(attester), filterRule(castUIDSlice(schema))},
+		func(l types.Log) (EASRevoked, error) {
+			e, err := c.contract.ParseRevoked(l)
+			if err != nil {
+				return EASRevoked{}, err
+			}
+			return *newEASRevoked(e), nil
+		})
 }
 
 func (c *EASContract) WatchRevoked(ctx context.Context, start *uint64, sink chan<- *EASRevoked, recipient []common.Address, attester []common.Address, schema []UID) (event.Subscription, error) {
-	s, err := c.contract.WatchRevoked(&bind.WatchOpts{Start: start, Context: ctx}, newChanProxy(ctx, sink, newEASRevoked), recipient, attester, castUIDSlice(schema))
-	if err != nil {
-		return nil, c.unpackError(err)
-	}
-	return s, nil
+	return watchLogs(ctx, c.client, c.client.easContractAddress, c.abi.Events["Revoked"], start,
+		[][]any{filterRule(recipient), filterRule(attester), filterRule(castUIDSlice(schema))},
+		newParseProxy(c.contract.ParseRevoked, newEASRevoked), sink)
+}
+
+func (c *EASContract) StreamRevoked(ctx context.Context, o *StreamOptions, sink chan<- StreamEvent[*EASRevoked], recipient []common.Address, attester []common.Address, schema []UID) (event.Subscription, error) {
+	return streamLogs(ctx, c.client, c.client.easContractAddress, c.abi.Events["Revoked"], o,
+		[][]any{filterRule(recipient), filterRule(attester), filterRule(castUIDSlice(schema))},
+		newParseProxy(c.contract.ParseRevoked, newEASRevoked), sink)
 }
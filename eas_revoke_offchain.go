@@ -45,7 +45,19 @@ func (c *EASContract) RevokeOffchain(ctx context.Context, uid UID) (*types.Trans
 		return nil, nil, fmt.Errorf("call revoke offchain contract method: %w", c.unpackError(err))
 	}
 
-	return tx, newWaitTx(tx, c.client, newParseProxy(c.contract.ParseRevokedOffchain, newEASRevokedOffchain)), nil
+	return tx, c.WaitRevokeOffchain(tx), nil
+}
+
+// RevokeOffchainCall returns the call of the offchain revocation that can be
+// signed and sent as a transaction by any account.
+func (c *EASContract) RevokeOffchainCall(uid UID) (*Call, error) {
+	return c.client.newCall(c.client.easContractAddress, c.abi, nil, "revokeOffchain", uid)
+}
+
+// WaitRevokeOffchain returns the function that waits for the offchain
+// revocation transaction to be mined, regardless of the account that sent it.
+func (c *EASContract) WaitRevokeOffchain(tx *types.Transaction) WaitTx[EASRevokedOffchain] {
+	return newWaitTx(tx, c.client, c.client.easContractAddress, c.abi.Events["RevokedOffchain"], newParseProxy(c.contract.ParseRevokedOffchain, newEASRevokedOffchain))
 }
 
 func (c *EASContract) MultiRevokeOffchain(ctx context.Context, schemaUID UID, uids []UID) (*types.Transaction, WaitTxMulti[EASRevokedOffchain], error) {
@@ -58,7 +70,20 @@ func (c *EASContract) MultiRevokeOffchain(ctx context.Context, schemaUID UID, ui
 	if err != nil {
 		return nil, nil, fmt.Errorf("call multiple revoke offchain contract method: %w", c.unpackError(err))
 	}
-	return tx, newWaitTxMulti(tx, c.client, newParseProxy(c.contract.ParseRevokedOffchain, newEASRevokedOffchain)), nil
+	return tx, c.WaitMultiRevokeOffchain(tx), nil
+}
+
+// MultiRevokeOffchainCall returns the call of multiple offchain revocations
+// that can be signed and sent as a transaction by any account.
+func (c *EASContract) MultiRevokeOffchainCall(uids []UID) (*Call, error) {
+	return c.client.newCall(c.client.easContractAddress, c.abi, nil, "multiRevokeOffchain", castUIDSlice(uids))
+}
+
+// WaitMultiRevokeOffchain returns the function that waits for the multiple
+// offchain revocations transaction to be mined, regardless of the account that
+// sent it.
+func (c *EASContract) WaitMultiRevokeOffchain(tx *types.Transaction) WaitTxMulti[EASRevokedOffchain] {
+	return newWaitTxMulti(tx, c.client, c.client.easContractAddress, c.abi.Events["RevokedOffchain"], newParseProxy(c.contract.ParseRevokedOffchain, newEASRevokedOffchain))
 }
 
 func (c *EASContract) GetRevokeOffchain(ctx context.Context, revoker common.Address, uid UID) (uint64, error) {
@@ -69,26 +94,39 @@ func (c *EASContract) GetRevokeOffchain(ctx context.Context, revoker common.Addr
 	return id, nil
 }
 
-type easRevokedOffchainIterator struct {
-	contracts.EASRevokedOffchainIterator
-}
-
-func (i *easRevokedOffchainIterator) Value() EASRevokedOffchain {
-	return *newEASRevokedOffchain(i.Event)
+// GetRevokeOffchains returns offchain revocation times of the revoker for
+// uids in as few requests as possible. Errors of individual calls are set in
+// results.
+func (c *EASContract) GetRevokeOffchains(ctx context.Context, revoker common.Address, uids []UID) ([]BatchResult[uint64], error) {
+	args := make([][]any, 0, len(uids))
+	for _, uid := range uids {
+		args = append(args, []any{revoker, uid})
+	}
+	return batch(ctx, c.client, c.client.easContractAddress, c.abi, "getRevokeOffchain", args, func(out []any) uint64 {
+		return convertValue[uint64](out[0])
+	})
 }
 
 func (c *EASContract) FilterRevokedOffchain(ctx context.Context, start uint64, end *uint64, revoker []common.Address, data []UID, timestamp []uint64) (Iterator[EASRevokedOffchain], error) {
-	it, err := c.contract.FilterRevokedOffchain(&bind.FilterOpts{Start: start, End: end, Context: ctx}, revoker, castUIDSlice(data), timestamp)
-	if err != nil {
-		return nil, c.unpackError(err)
-	}
-	return &easRevokedOffchainIterator{*it}, nil
+	return newFilterIterator(ctx, c.client, c.client.easContractAddress, c.abi.Events["RevokedOffchain"], c.client.filterStart(start), end,
+		[][]any{filterRule(revoker), filterRule(castUIDSlice(data)), filterRule(timestamp)},
+		func(l types.Log) (EASRevokedOffchain, error) {
+			e, err := c.contract.ParseRevokedOffchain(l)
+			if err != nil {
+				return EASRevokedOffchain{}, err
+			}
+			return *newEASRevokedOffchain(e), nil
+		})
 }
 
 func (c *EASContract) WatchRevokedOffchain(ctx context.Context, start *uint64, sink chan<- *EASRevokedOffchain, revoker []common.Address, data []UID, timestamp []uint64) (event.Subscription, error) {
-	s, err := c.contract.WatchRevokedOffchain(&bind.WatchOpts{Start: start, Context: ctx}, newChanProxy(ctx, sink, newEASRevokedOffchain), revoker, castUIDSlice(data), timestamp)
-	if err != nil {
-		return nil, c.unpackError(err)
-	}
-	return s, nil
+	return watchLogs(ctx, c.client, c.client.easContractAddress, c.abi.Events["RevokedOffchain"], start,
+		[][]any{filterRule(revoker), filterRule(castUIDSlice(data)), filterRule(timestamp)},
+		newParseProxy(c.contract.ParseRevokedOffchain, newEASRevokedOffchain), sink)
+}
+
+func (c *EASContract) StreamRevokedOffchain(ctx context.Context, o *StreamOptions, sink chan<- StreamEvent[*EASRevokedOffchain], revoker []common.Address, data []UID, timestamp []uint64) (event.Subscription, error) {
+	return streamLogs(ctx, c.client, c.client.easContractAddress, c.abi.Events["RevokedOffchain"], o,
+		[][]any{filterRule(revoker), filterRule(castUIDSlice(data)), filterRule(timestamp)},
+		newParseProxy(c.contract.ParseRevokedOffchain, newEASRevokedOffchain), sink)
 }
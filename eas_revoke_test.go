@@ -206,3 +206,29 @@ loop:
 
 	assertEqual(t, "count", count, 5)
 }
+
+func TestEASContract_SimulateRevoke(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	schemaUID := registerSchema(t, client, "string message")
+
+	revocable := attest(t, client, schemaUID, &eas.AttestOptions{Revocable: true}, "one")
+	irrevocable := attest(t, client, schemaUID, nil, "two")
+
+	assertNilError(t, client.EAS.SimulateRevoke(ctx, schemaUID, revocable, nil))
+
+	client.backend.Commit()
+
+	a, err := client.EAS.GetAttestation(ctx, revocable)
+	assertNilError(t, err)
+	assertEqual(t, "revoked", a.IsRevoked(), false)
+
+	err = client.EAS.SimulateRevoke(ctx, schemaUID, irrevocable, nil)
+	assertErrorIs(t, err, eas.ErrIrrevocable)
+
+	assertNilError(t, client.EAS.SimulateMultiRevoke(ctx, schemaUID, []eas.UID{revocable}))
+
+	err = client.EAS.SimulateMultiRevoke(ctx, schemaUID, []eas.UID{revocable, irrevocable})
+	assertErrorIs(t, err, eas.ErrIrrevocable)
+}
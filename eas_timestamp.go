@@ -55,7 +55,28 @@ func (c *EASContract) Timestamp(ctx context.Context, data UID) (*types.Transacti
 		return nil, nil, fmt.Errorf("call timestamp contract method: %w", c.unpackError(err))
 	}
 
-	return tx, newWaitTx(tx, c.client, newParseProxy(c.contract.ParseTimestamped, newEASTimestamped)), nil
+	return tx, c.WaitTimestamp(tx), nil
+}
+
+// TimestampCall returns the call of the timestamp that can be signed and sent
+// as a transaction by any account.
+func (c *EASContract) TimestampCall(data UID) (*Call, error) {
+	return c.client.newCall(c.client.easContractAddress, c.abi, nil, "timestamp", data)
+}
+
+// WaitTimestamp returns the function that waits for the timestamp transaction
+// to be mined, regardless of the account that sent it.
+func (c *EASContract) WaitTimestamp(tx *types.Transaction) WaitTx[EASTimestamped] {
+	return newWaitTx(tx, c.client, c.client.easContractAddress, c.abi.Events["Timestamped"], newParseProxy(c.contract.ParseTimestamped, newEASTimestamped))
+}
+
+// EstimateTimestamp estimates the gas and fee of the timestamp transaction.
+func (c *EASContract) EstimateTimestamp(ctx context.Context, data UID) (*Estimate, error) {
+	e, err := c.client.estimate(ctx, c.client.easContractAddress, c.abi, nil, "timestamp", data)
+	if err != nil {
+		return nil, c.unpackError(err)
+	}
+	return e, nil
 }
 
 func (c *EASContract) MultiTimestamp(ctx context.Context, data []UID) (*types.Transaction, WaitTxMulti[EASTimestamped], error) {
@@ -68,7 +89,19 @@ func (c *EASContract) MultiTimestamp(ctx context.Context, data []UID) (*types.Tr
 	if err != nil {
 		return nil, nil, fmt.Errorf("call multi timestamp contract method: %w", c.unpackError(err))
 	}
-	return tx, newWaitTxMulti(tx, c.client, newParseProxy(c.contract.ParseTimestamped, newEASTimestamped)), nil
+	return tx, c.WaitMultiTimestamp(tx), nil
+}
+
+// MultiTimestampCall returns the call of multiple timestamps that can be
+// signed and sent as a transaction by any account.
+func (c *EASContract) MultiTimestampCall(data []UID) (*Call, error) {
+	return c.client.newCall(c.client.easContractAddress, c.abi, nil, "multiTimestamp", castUIDSlice(data))
+}
+
+// WaitMultiTimestamp returns the function that waits for the multiple
+// timestamps transaction to be mined, regardless of the account that sent it.
+func (c *EASContract) WaitMultiTimestamp(tx *types.Transaction) WaitTxMulti[EASTimestamped] {
+	return newWaitTxMulti(tx, c.client, c.client.easContractAddress, c.abi.Events["Timestamped"], newParseProxy(c.contract.ParseTimestamped, newEASTimestamped))
 }
 
 func (c *EASContract) GetTimestamp(ctx context.Context, data UID) (Timestamp, error) {
@@ -79,26 +112,34 @@ func (c *EASContract) GetTimestamp(ctx context.Context, data UID) (Timestamp, er
 	return Timestamp(timestamp), nil
 }
 
-type easTimestampedIterator struct {
-	contracts.EASTimestampedIterator
-}
-
-func (i *easTimestampedIterator) Value() EASTimestamped {
-	return *newEASTimestamped(i.Event)
+// GetTimestamps returns timestamps of data in as few requests as possible.
+// Errors of individual calls are set in results.
+func (c *EASContract) GetTimestamps(ctx context.Context, data []UID) ([]BatchResult[Timestamp], error) {
+	return batch(ctx, c.client, c.client.easContractAddress, c.abi, "getTimestamp", uidArgs(data), func(out []any) Timestamp {
+		return Timestamp(convertValue[uint64](out[0]))
+	})
 }
 
 func (c *EASContract) FilterTimestamped(ctx context.Context, start uint64, end *uint64, data []UID, timestamps []Timestamp) (Iterator[EASTimestamped], error) {
-	it, err := c.contract.FilterTimestamped(&bind.FilterOpts{Start: start, End: end, Context: ctx}, castUIDSlice(data), castTimestampSlice(timestamps))
-	if err != nil {
-		return nil, c.unpackError(err)
-	}
-	return &easTimestampedIterator{*it}, nil
+	return newFilterIterator(ctx, c.client, c.client.easContractAddress, c.abi.Events["Timestamped"], c.client.filterStart(start), end,
+		[][]any{filterRule(castUIDSlice(data)), filterRule(castTimestampSlice(timestamps))},
+		func(l types.Log) (EASTimestamped, error) {
+			e, err := c.contract.ParseTimestamped(l)
+			if err != nil {
+				return EASTimestamped{}, err
+			}
+			return *newEASTimestamped(e), nil
+		})
 }
 
 func (c *EASContract) WatchTimestamped(ctx context.Context, start *uint64, sink chan<- *EASTimestamped, data []UID, timestamps []Timestamp) (event.Subscription, error) {
-	s, err := c.contract.WatchTimestamped(&bind.WatchOpts{Start: start, Context: ctx}, newChanProxy(ctx, sink, newEASTimestamped), castUIDSlice(data), castTimestampSlice(timestamps))
-	if err != nil {
-		return nil, c.unpackError(err)
-	}
-	return s, nil
+	return watchLogs(ctx, c.client, c.client.easContractAddress, c.abi.Events["Timestamped"], start,
+		[][]any{filterRule(castUIDSlice(data)), filterRule(castTimestampSlice(timestamps))},
+		newParseProxy(c.contract.ParseTimestamped, newEASTimestamped), sink)
+}
+
+func (c *EASContract) StreamTimestamped(ctx context.Context, o *StreamOptions, sink chan<- StreamEvent[*EASTimestamped], data []UID, timestamps []Timestamp) (event.Subscription, error) {
+	return streamLogs(ctx, c.client, c.client.easContractAddress, c.abi.Events["Timestamped"], o,
+		[][]any{filterRule(castUIDSlice(data)), filterRule(castTimestampSlice(timestamps))},
+		newParseProxy(c.contract.ParseTimestamped, newEASTimestamped), sink)
 }
@@ -0,0 +1,133 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eastest
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"resenje.org/eas/deploy"
+	"resenje.org/eas/internal/contracts"
+)
+
+// Deploy returns a function that mines the deployment transaction and returns
+// the address of the deployed contract, failing the test on any error. It
+// accepts results of deploy package functions directly:
+//
+//	indexer := h.Deploy(t)(deploy.Indexer(ctx, h.Simulated.Client(), h.Accounts[0].PrivateKey, h.Addresses.EAS, nil))
+func (h *Harness) Deploy(t testing.TB) func(common.Address, *types.Transaction, deploy.WaitDeployment, error) common.Address {
+	return func(_ common.Address, _ *types.Transaction, wait deploy.WaitDeployment, err error) common.Address {
+		t.Helper()
+
+		assertNilError(t, err)
+
+		h.Simulated.Commit()
+
+		address, err := wait(context.Background())
+		assertNilError(t, err)
+
+		return address
+	}
+}
+
+// DeployAttesterResolver deploys the example schema resolver that accepts
+// only attestations from the provided attesters.
+func (h *Harness) DeployAttesterResolver(t testing.TB, attesters []common.Address) common.Address {
+	t.Helper()
+
+	address, _ := deployContract(t, h, func(o *bind.TransactOpts, b bind.ContractBackend) (common.Address, *types.Transaction, any, error) {
+		return contracts.DeployAttesterResolver(o, b, h.Addresses.EAS, attesters)
+	})
+	return address
+}
+
+// DeployValueResolver deploys the example payable schema resolver that
+// accepts only attestations with exactly the provided value.
+func (h *Harness) DeployValueResolver(t testing.TB, value *big.Int) common.Address {
+	t.Helper()
+
+	address, _ := deployContract(t, h, func(o *bind.TransactOpts, b bind.ContractBackend) (common.Address, *types.Transaction, any, error) {
+		return contracts.DeployValueResolver(o, b, h.Addresses.EAS, value)
+	})
+	return address
+}
+
+// DeployExpirationTimeResolver deploys the example schema resolver that
+// accepts only attestations that expire at or after the provided time.
+func (h *Harness) DeployExpirationTimeResolver(t testing.TB, validAfter time.Time) common.Address {
+	t.Helper()
+
+	address, _ := deployContract(t, h, func(o *bind.TransactOpts, b bind.ContractBackend) (common.Address, *types.Transaction, any, error) {
+		return contracts.DeployExpirationTimeResolver(o, b, h.Addresses.EAS, big.NewInt(validAfter.Unix()))
+	})
+	return address
+}
+
+// DeployTokenResolver deploys the example schema resolver that accepts only
+// attestations from attesters that hold at least the minimal balance of the
+// ERC20 token on the provided address.
+func (h *Harness) DeployTokenResolver(t testing.TB, token common.Address, minimalBalance *big.Int) common.Address {
+	t.Helper()
+
+	address, _ := deployContract(t, h, func(o *bind.TransactOpts, b bind.ContractBackend) (common.Address, *types.Transaction, any, error) {
+		return contracts.DeployTokenResolver(o, b, h.Addresses.EAS, token, minimalBalance)
+	})
+	return address
+}
+
+// DeployToken deploys a minimal ERC20 token contract with the provided
+// balances minted, for example to test token gated schema resolvers.
+func (h *Harness) DeployToken(t testing.TB, balances map[common.Address]*big.Int) common.Address {
+	t.Helper()
+
+	var token *contracts.TestToken
+	address, txOpts := deployContract(t, h, func(o *bind.TransactOpts, b bind.ContractBackend) (common.Address, *types.Transaction, any, error) {
+		address, tx, contract, err := contracts.DeployTestToken(o, b)
+		token = contract
+		return address, tx, contract, err
+	})
+
+	for account, balance := range balances {
+		_, err := token.Mint(txOpts, account, balance)
+		assertNilError(t, err)
+
+		h.Simulated.Commit()
+	}
+
+	return address
+}
+
+// deployContract deploys the contract with the first account and mines the
+// deployment transaction.
+func deployContract(t testing.TB, h *Harness, deploy func(*bind.TransactOpts, bind.ContractBackend) (common.Address, *types.Transaction, any, error)) (common.Address, *bind.TransactOpts) {
+	t.Helper()
+
+	ctx := context.Background()
+	backend := h.Simulated.Client()
+
+	chainID, err := backend.ChainID(ctx)
+	assertNilError(t, err)
+
+	txOpts, err := bind.NewKeyedTransactorWithChainID(h.Accounts[0].PrivateKey, chainID)
+	assertNilError(t, err)
+	txOpts.Context = ctx
+
+	address, tx, _, err := deploy(txOpts, backend)
+	assertNilError(t, err)
+
+	h.Simulated.Commit()
+
+	_, err = bind.WaitDeployed(ctx, backend, tx)
+	assertNilError(t, err)
+
+	return address, txOpts
+}
@@ -13,9 +13,11 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
 	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/node"
 
-	"resenje.org/eas/internal/deployment"
+	"resenje.org/eas/deploy"
 )
 
 // NewSimulatedBackend returns a simulated backend that has accounts with
@@ -24,6 +26,18 @@ import (
 func NewSimulatedBackend(t testing.TB, accounts map[common.Address]*big.Int) (*simulated.Backend, common.Address) {
 	t.Helper()
 
+	sim, addresses := newSimulatedBackend(t, accounts)
+
+	return sim, addresses.EAS
+}
+
+// EIP712ProxyName is the name of the EIP712Proxy contract deployed on the
+// simulated backend.
+const EIP712ProxyName = "EIP712Proxy"
+
+func newSimulatedBackend(t testing.TB, accounts map[common.Address]*big.Int, options ...func(*node.Config, *ethconfig.Config)) (*simulated.Backend, deploy.Addresses) {
+	t.Helper()
+
 	ctx := context.Background()
 
 	privateKey, err := crypto.GenerateKey()
@@ -32,10 +46,8 @@ func NewSimulatedBackend(t testing.TB, accounts map[common.Address]*big.Int) (*s
 	balance := new(big.Int)
 	balance.SetString("100000000000000000000", 10)
 
-	accountAddress := crypto.PubkeyToAddress(privateKey.PublicKey)
-
 	alloc := types.GenesisAlloc{
-		accountAddress: {
+		crypto.PubkeyToAddress(privateKey.PublicKey): {
 			Balance: balance,
 		},
 	}
@@ -46,34 +58,26 @@ func NewSimulatedBackend(t testing.TB, accounts map[common.Address]*big.Int) (*s
 		}
 	}
 
-	sim := simulated.NewBackend(alloc)
+	sim := simulated.NewBackend(alloc, options...)
 	t.Cleanup(func() {
 		if err := sim.Close(); err != nil {
 			t.Error(err)
 		}
 	})
 
-	backend := sim.Client()
-
-	// deploy schema registry contract
-	_, _, _, wait, err := deployment.DeploySchemaRegistry(ctx, backend, privateKey)
-	assertNilError(t, err)
-
-	sim.Commit()
-
-	schemaRegistryAddress, err := wait(ctx)
-	assertNilError(t, err)
-
-	// deploy eas contract
-	_, _, _, wait, err = deployment.DeployEAS(ctx, backend, privateKey, schemaRegistryAddress)
+	// deploy schema registry, eas, eip712 proxy and indexer contracts
+	_, _, wait, err := deploy.Contracts(ctx, sim.Client(), privateKey, &deploy.ContractsOptions{
+		EIP712ProxyName: EIP712ProxyName,
+		Indexer:         true,
+	})
 	assertNilError(t, err)
 
 	sim.Commit()
 
-	easAddress, err := wait(ctx)
+	addresses, err := wait(ctx)
 	assertNilError(t, err)
 
-	return sim, easAddress
+	return sim, addresses
 }
 
 func assertNilError(t testing.TB, err error) {
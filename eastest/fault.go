@@ -0,0 +1,328 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eastest
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"resenje.org/eas"
+)
+
+// Fault describes a failure that FaultBackend injects into calls of a Backend
+// method.
+type Fault struct {
+	// Method is the name of the Backend method, such as "CallContract" or
+	// "SendTransaction". Fault with an empty method applies to all methods.
+	Method string
+	// Times is the number of calls that the fault applies to. If not set, it
+	// applies to all calls.
+	Times int
+	// Latency delays the call or until the context is done.
+	Latency time.Duration
+	// Err is returned instead of calling the backend.
+	Err error
+	// Drop makes SendTransaction return without sending the transaction.
+	Drop bool
+}
+
+// FaultBackend wraps a Backend and injects faults into its calls.
+type FaultBackend struct {
+	backend eas.Backend
+	sim     *simulated.Backend
+
+	mu     sync.Mutex
+	faults []*Fault
+	calls  map[string]int
+}
+
+var _ eas.Backend = (*FaultBackend)(nil)
+
+// NewFaultBackend returns a FaultBackend that wraps the provided backend. If
+// backend is nil, the client of the simulated backend is used.
+func NewFaultBackend(sim *simulated.Backend, backend eas.Backend) *FaultBackend {
+	if backend == nil {
+		backend = sim.Client()
+	}
+	return &FaultBackend{
+		backend: backend,
+		sim:     sim,
+		calls:   make(map[string]int),
+	}
+}
+
+// Inject adds a fault. Faults are applied in the order they are added.
+func (b *FaultBackend) Inject(f Fault) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.faults = append(b.faults, &f)
+}
+
+// Reset removes all faults and call counts.
+func (b *FaultBackend) Reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.faults = nil
+	b.calls = make(map[string]int)
+}
+
+// Calls returns the number of calls of the Backend method.
+func (b *FaultBackend) Calls(method string) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.calls[method]
+}
+
+// Reorg replaces the last depth blocks of the simulated chain with a longer
+// chain. Transactions from replaced blocks are included in new blocks.
+func (b *FaultBackend) Reorg(ctx context.Context, depth uint64) error {
+	b.sim.Commit()
+
+	header, err := b.sim.Client().HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("get latest header: %w", err)
+	}
+	if header.Number.Uint64() < depth {
+		return fmt.Errorf("reorg depth %v greater than chain length %v", depth, header.Number)
+	}
+
+	number := header.Number.Uint64() - depth
+
+	var txs []*types.Transaction
+	for n := number + 1; n <= header.Number.Uint64(); n++ {
+		block, err := b.sim.Client().BlockByNumber(ctx, new(big.Int).SetUint64(n))
+		if err != nil {
+			return fmt.Errorf("get block %v: %w", n, err)
+		}
+		txs = append(txs, block.Transactions()...)
+	}
+
+	parent, err := b.sim.Client().HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+	if err != nil {
+		return fmt.Errorf("get parent header: %w", err)
+	}
+
+	if err := b.sim.Fork(parent.Hash()); err != nil {
+		return fmt.Errorf("fork: %w", err)
+	}
+
+	// the first block on the fork resets the transaction pool state, as
+	// transactions from removed blocks are not returned to the pool and have
+	// to be sent again
+	b.sim.Commit()
+
+	for _, tx := range txs {
+		if err := b.sim.Client().SendTransaction(ctx, tx); err != nil {
+			return fmt.Errorf("send transaction %s: %w", tx.Hash(), err)
+		}
+	}
+
+	for range depth {
+		b.sim.Commit()
+	}
+	return nil
+}
+
+// fault records the call and applies matching faults. It reports if the
+// transaction should be dropped.
+func (b *FaultBackend) fault(ctx context.Context, method string) (drop bool, err error) {
+	b.mu.Lock()
+	b.calls[method]++
+	var f *Fault
+	for i, v := range b.faults {
+		if v.Method != "" && v.Method != method {
+			continue
+		}
+		f = v
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				b.faults = append(b.faults[:i], b.faults[i+1:]...)
+			}
+		}
+		break
+	}
+	b.mu.Unlock()
+
+	if f == nil {
+		return false, nil
+	}
+
+	if f.Latency > 0 {
+		timer := time.NewTimer(f.Latency)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+	}
+
+	return f.Drop, f.Err
+}
+
+func (b *FaultBackend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	if _, err := b.fault(ctx, "CodeAt"); err != nil {
+		return nil, err
+	}
+	return b.backend.CodeAt(ctx, contract, blockNumber)
+}
+
+func (b *FaultBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if _, err := b.fault(ctx, "CallContract"); err != nil {
+		return nil, err
+	}
+	return b.backend.CallContract(ctx, call, blockNumber)
+}
+
+func (b *FaultBackend) PendingCallContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error) {
+	if _, err := b.fault(ctx, "PendingCallContract"); err != nil {
+		return nil, err
+	}
+	if pending, ok := b.backend.(ethereum.PendingContractCaller); ok {
+		return pending.PendingCallContract(ctx, call)
+	}
+	return b.backend.CallContract(ctx, call, nil)
+}
+
+func (b *FaultBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if _, err := b.fault(ctx, "HeaderByNumber"); err != nil {
+		return nil, err
+	}
+	return b.backend.HeaderByNumber(ctx, number)
+}
+
+func (b *FaultBackend) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	if _, err := b.fault(ctx, "PendingCodeAt"); err != nil {
+		return nil, err
+	}
+	return b.backend.PendingCodeAt(ctx, account)
+}
+
+func (b *FaultBackend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	if _, err := b.fault(ctx, "PendingNonceAt"); err != nil {
+		return 0, err
+	}
+	return b.backend.PendingNonceAt(ctx, account)
+}
+
+func (b *FaultBackend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	if _, err := b.fault(ctx, "SuggestGasPrice"); err != nil {
+		return nil, err
+	}
+	return b.backend.SuggestGasPrice(ctx)
+}
+
+func (b *FaultBackend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	if _, err := b.fault(ctx, "SuggestGasTipCap"); err != nil {
+		return nil, err
+	}
+	return b.backend.SuggestGasTipCap(ctx)
+}
+
+func (b *FaultBackend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	if _, err := b.fault(ctx, "EstimateGas"); err != nil {
+		return 0, err
+	}
+	return b.backend.EstimateGas(ctx, call)
+}
+
+func (b *FaultBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	drop, err := b.fault(ctx, "SendTransaction")
+	if err != nil {
+		return err
+	}
+	if drop {
+		return nil
+	}
+	return b.backend.SendTransaction(ctx, tx)
+}
+
+func (b *FaultBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	if _, err := b.fault(ctx, "FilterLogs"); err != nil {
+		return nil, err
+	}
+	return b.backend.FilterLogs(ctx, query)
+}
+
+func (b *FaultBackend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	if _, err := b.fault(ctx, "SubscribeFilterLogs"); err != nil {
+		return nil, err
+	}
+	return b.backend.SubscribeFilterLogs(ctx, query, ch)
+}
+
+func (b *FaultBackend) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if _, err := b.fault(ctx, "TransactionReceipt"); err != nil {
+		return nil, err
+	}
+	return b.backend.TransactionReceipt(ctx, txHash)
+}
+
+func (b *FaultBackend) ChainID(ctx context.Context) (*big.Int, error) {
+	if _, err := b.fault(ctx, "ChainID"); err != nil {
+		return nil, err
+	}
+	return b.backend.ChainID(ctx)
+}
+
+// HTTPError returns an error as returned by the RPC client for a response with
+// a non-successful status code and the JSON encoded body.
+func HTTPError(statusCode int, body any) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		panic(err)
+	}
+	return rpc.HTTPError{
+		StatusCode: statusCode,
+		Status:     fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		Body:       b,
+	}
+}
+
+// RevertError returns an error as returned by the RPC client for a reverted
+// execution with the provided revert data.
+func RevertError(data []byte) error {
+	return &revertError{data: data}
+}
+
+type revertError struct {
+	data []byte
+}
+
+func (e *revertError) Error() string {
+	return "execution reverted"
+}
+
+func (e *revertError) ErrorCode() int {
+	return 3
+}
+
+func (e *revertError) ErrorData() any {
+	return "0x" + hex.EncodeToString(e.data)
+}
+
+var _ rpc.DataError = (*revertError)(nil)
+
+// ErrInjected is a generic error that can be used as an injected fault.
+var ErrInjected = errors.New("injected fault")
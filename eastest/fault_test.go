@@ -0,0 +1,166 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eastest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"resenje.org/eas"
+	"resenje.org/eas/eastest"
+)
+
+func TestFaultBackend_error(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, &eastest.Options{Faults: true})
+	c := h.Client()
+
+	calls := h.Faults.Calls("CallContract")
+
+	h.Faults.Inject(eastest.Fault{
+		Method: "CallContract",
+		Times:  1,
+		Err:    eastest.ErrInjected,
+	})
+
+	_, err := c.EAS.GetAttestation(ctx, eas.UID{1})
+	if !errors.Is(err, eastest.ErrInjected) {
+		t.Fatalf("got error %v, want %v", err, eastest.ErrInjected)
+	}
+
+	_, err = c.EAS.GetAttestation(ctx, eas.UID{1})
+	assertNilError(t, err)
+
+	if got := h.Faults.Calls("CallContract") - calls; got != 2 {
+		t.Errorf("got %v calls, want 2", got)
+	}
+}
+
+func TestFaultBackend_latency(t *testing.T) {
+	h := eastest.New(t, &eastest.Options{Faults: true})
+
+	h.Faults.Inject(eastest.Fault{
+		Latency: time.Minute,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := h.Client().EAS.GetAttestation(ctx, eas.UID{1})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	h.Faults.Reset()
+
+	_, err = h.Client().EAS.GetAttestation(context.Background(), eas.UID{1})
+	assertNilError(t, err)
+}
+
+func TestFaultBackend_httpError(t *testing.T) {
+	h := eastest.New(t, &eastest.Options{Faults: true})
+
+	h.Faults.Inject(eastest.Fault{
+		Method: "CallContract",
+		Err: eastest.HTTPError(http.StatusTooManyRequests, map[string]any{
+			"jsonrpc": "2.0",
+			"error": map[string]any{
+				"code":    -32005,
+				"message": "rate limit exceeded",
+			},
+		}),
+	})
+
+	_, err := h.Client().EAS.GetAttestation(context.Background(), eas.UID{1})
+	var e *eas.Error
+	if !errors.As(err, &e) {
+		t.Fatalf("got error %T %v, want %T", err, err, e)
+	}
+	if e.StatusCode != http.StatusTooManyRequests || e.Code != -32005 || e.Message != "rate limit exceeded" {
+		t.Errorf("got error %+v", e)
+	}
+}
+
+func TestFaultBackend_revert(t *testing.T) {
+	h := eastest.New(t, &eastest.Options{Faults: true})
+
+	h.Faults.Inject(eastest.Fault{
+		Method: "CallContract",
+		Err:    eastest.RevertError(crypto.Keccak256([]byte("AccessDenied()"))[:4]),
+	})
+
+	_, err := h.Client().EAS.GetAttestation(context.Background(), eas.UID{1})
+	var e *eas.ContractError
+	if !errors.As(err, &e) {
+		t.Fatalf("got error %T %v, want %T", err, err, e)
+	}
+	if e.Name != "AccessDenied" {
+		t.Errorf("got error name %q, want %q", e.Name, "AccessDenied")
+	}
+}
+
+func TestFaultBackend_drop(t *testing.T) {
+	h := eastest.New(t, &eastest.Options{
+		AutoCommit: true,
+		Faults:     true,
+	})
+
+	h.Faults.Inject(eastest.Fault{
+		Method: "SendTransaction",
+		Times:  1,
+		Drop:   true,
+	})
+
+	tx, wait, err := h.Client().EAS.Timestamp(context.Background(), eas.UID{1})
+	assertNilError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err = wait(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	if _, err := h.Simulated.Client().TransactionReceipt(context.Background(), tx.Hash()); err == nil {
+		t.Error("dropped transaction is mined")
+	}
+}
+
+func TestFaultBackend_Reorg(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, &eastest.Options{
+		AutoCommit: true,
+		Faults:     true,
+	})
+
+	_, wait, err := h.Client().SchemaRegistry.Register(ctx, "string message", common.Address{}, true)
+	assertNilError(t, err)
+	r, err := wait(ctx)
+	assertNilError(t, err)
+
+	assertNilError(t, h.Faults.Reorg(ctx, 2))
+
+	receipt, err := h.Simulated.Client().TransactionReceipt(ctx, r.Raw.TxHash)
+	assertNilError(t, err)
+	if receipt.BlockHash == r.Raw.BlockHash {
+		t.Error("transaction block is not replaced")
+	}
+
+	schema, err := h.Client().SchemaRegistry.GetSchema(ctx, r.UID)
+	assertNilError(t, err)
+	if schema.UID != r.UID {
+		t.Errorf("got schema uid %s, want %s", schema.UID, r.UID)
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eastest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/node"
+
+	"resenje.org/eas"
+	"resenje.org/eas/deploy"
+)
+
+// Options configure the Harness.
+type Options struct {
+	// Accounts is the number of funded accounts with constructed clients. If
+	// not set, one account is created.
+	Accounts int
+	// Balance of every account. If not set, 100 ether is used.
+	Balance *big.Int
+	// Alloc sets balances of additional accounts.
+	Alloc map[common.Address]*big.Int
+	// AutoCommit mines a new block after every sent transaction, so that
+	// transaction waiting functions return without explicit commits.
+	AutoCommit bool
+	// Faults wraps the backend of clients with the FaultBackend.
+	Faults bool
+	// HTTP starts the JSON-RPC HTTP server of the simulated backend on a
+	// local port, with the endpoint returned by the Endpoint method.
+	HTTP bool
+}
+
+// Account is a funded account with a client connected to the simulated
+// backend.
+type Account struct {
+	PrivateKey *ecdsa.PrivateKey
+	Client     *eas.Client
+}
+
+// Harness is a simulated backend with deployed EAS contracts and funded
+// accounts.
+type Harness struct {
+	Simulated *simulated.Backend
+	Addresses deploy.Addresses
+	Accounts  []Account
+	// Faults is the backend of clients if the Faults option is set.
+	Faults *FaultBackend
+
+	backend   eas.Backend
+	endpoint  string
+	ethClient *ethclient.Client
+}
+
+// New returns a new Harness with deployed EAS contracts and clients for funded
+// accounts. The simulated backend is closed on test cleanup.
+func New(t testing.TB, o *Options) *Harness {
+	t.Helper()
+
+	if o == nil {
+		o = new(Options)
+	}
+
+	count := o.Accounts
+	if count <= 0 {
+		count = 1
+	}
+
+	balance := o.Balance
+	if balance == nil {
+		balance = new(big.Int)
+		balance.SetString("100000000000000000000", 10)
+	}
+
+	keys := make([]*ecdsa.PrivateKey, 0, count)
+	alloc := make(map[common.Address]*big.Int, count+len(o.Alloc))
+	for address, balance := range o.Alloc {
+		alloc[address] = balance
+	}
+	for range count {
+		privateKey, err := crypto.GenerateKey()
+		assertNilError(t, err)
+
+		keys = append(keys, privateKey)
+		alloc[crypto.PubkeyToAddress(privateKey.PublicKey)] = balance
+	}
+
+	var options []func(*node.Config, *ethconfig.Config)
+	var endpoint string
+	if o.HTTP {
+		port := freePort(t)
+		endpoint = fmt.Sprintf("http://127.0.0.1:%v", port)
+		options = append(options, func(nodeConf *node.Config, _ *ethconfig.Config) {
+			nodeConf.HTTPHost = "127.0.0.1"
+			nodeConf.HTTPPort = port
+			nodeConf.HTTPModules = []string{"eth", "net", "web3"}
+			nodeConf.HTTPVirtualHosts = []string{"*"}
+		})
+	}
+
+	sim, addresses := newSimulatedBackend(t, alloc, options...)
+
+	h := &Harness{
+		Simulated: sim,
+		Addresses: addresses,
+		backend:   sim.Client(),
+		endpoint:  endpoint,
+	}
+	if endpoint != "" {
+		c, err := ethclient.Dial(endpoint)
+		assertNilError(t, err)
+		t.Cleanup(c.Close)
+		h.ethClient = c
+	}
+	if o.AutoCommit {
+		h.backend = &autoCommitBackend{
+			Client: sim.Client(),
+			sim:    sim,
+		}
+	}
+	if o.Faults {
+		h.Faults = NewFaultBackend(sim, h.backend)
+		h.backend = h.Faults
+	}
+
+	ctx := context.Background()
+	for _, privateKey := range keys {
+		c, err := eas.NewClient(ctx, "", privateKey, addresses.EAS, &eas.Options{
+			Backend:                    h.backend,
+			EIP712ProxyContractAddress: addresses.EIP712Proxy,
+			IndexerContractAddress:     addresses.Indexer,
+		})
+		assertNilError(t, err)
+
+		h.Accounts = append(h.Accounts, Account{
+			PrivateKey: privateKey,
+			Client:     c,
+		})
+	}
+
+	return h
+}
+
+// Client returns the client of the first account.
+func (h *Harness) Client() *eas.Client {
+	return h.Accounts[0].Client
+}
+
+// Backend returns the backend that clients are using. It mines blocks after
+// every sent transaction if the AutoCommit option is set and injects faults if
+// the Faults option is set.
+func (h *Harness) Backend() eas.Backend {
+	return h.backend
+}
+
+// Endpoint returns the URL of the JSON-RPC HTTP server of the simulated
+// backend if the HTTP option is set.
+func (h *Harness) Endpoint() string {
+	return h.endpoint
+}
+
+// EthClient returns the client connected to the JSON-RPC HTTP server of the
+// simulated backend if the HTTP option is set, or nil otherwise. Unlike the
+// client of the simulated backend, it supports JSON-RPC batch requests.
+func (h *Harness) EthClient() *ethclient.Client {
+	return h.ethClient
+}
+
+// Commit mines a new block.
+func (h *Harness) Commit() common.Hash {
+	return h.Simulated.Commit()
+}
+
+// BlockTime returns the timestamp of the latest block.
+func (h *Harness) BlockTime(t testing.TB) time.Time {
+	t.Helper()
+
+	header, err := h.Simulated.Client().HeaderByNumber(context.Background(), nil)
+	assertNilError(t, err)
+
+	return time.Unix(int64(header.Time), 0)
+}
+
+// AdvanceTime mines pending transactions and a new block with the timestamp
+// advanced by the provided duration, with the precision of one second.
+func (h *Harness) AdvanceTime(t testing.TB, d time.Duration) {
+	t.Helper()
+
+	// time can be adjusted only with no pending transactions
+	h.Simulated.Commit()
+
+	// simulated backend adds the adjustment value to the block time in
+	// seconds
+	assertNilError(t, h.Simulated.AdjustTime(d/time.Second))
+}
+
+type autoCommitBackend struct {
+	simulated.Client
+	sim *simulated.Backend
+}
+
+func (b *autoCommitBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if err := b.Client.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	b.sim.Commit()
+	return nil
+}
+
+// freePort returns a local TCP port that is not in use.
+func freePort(t testing.TB) int {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assertNilError(t, err)
+	defer l.Close()
+
+	return l.Addr().(*net.TCPAddr).Port
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eastest_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"resenje.org/eas"
+	"resenje.org/eas/eastest"
+)
+
+func TestHarness(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, &eastest.Options{
+		Accounts:   2,
+		AutoCommit: true,
+	})
+
+	if len(h.Accounts) != 2 {
+		t.Fatalf("got %v accounts, want 2", len(h.Accounts))
+	}
+	if h.Accounts[0].Client.Address() == h.Accounts[1].Client.Address() {
+		t.Error("accounts have the same address")
+	}
+	if h.Client() != h.Accounts[0].Client {
+		t.Error("client is not the first account client")
+	}
+
+	for _, a := range h.Accounts {
+		balance, err := h.Simulated.Client().BalanceAt(ctx, a.Client.Address(), nil)
+		assertNilError(t, err)
+		if balance.Sign() <= 0 {
+			t.Errorf("account %s is not funded", a.Client.Address())
+		}
+	}
+
+	// transactions are mined without explicit commits
+	_, wait, err := h.Client().SchemaRegistry.Register(ctx, "string message", common.Address{}, true)
+	assertNilError(t, err)
+
+	waitCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	r, err := wait(waitCtx)
+	assertNilError(t, err)
+
+	_, waitAttest, err := h.Accounts[1].Client.EAS.Attest(ctx, r.UID, nil, "hello")
+	assertNilError(t, err)
+
+	attested, err := waitAttest(waitCtx)
+	assertNilError(t, err)
+	if attested.Attester != h.Accounts[1].Client.Address() {
+		t.Errorf("got attester %s, want %s", attested.Attester, h.Accounts[1].Client.Address())
+	}
+}
+
+func TestHarness_AdvanceTime(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, &eastest.Options{
+		AutoCommit: true,
+	})
+	c := h.Client()
+
+	_, wait, err := c.SchemaRegistry.Register(ctx, "string message", common.Address{}, true)
+	assertNilError(t, err)
+	r, err := wait(ctx)
+	assertNilError(t, err)
+
+	start := h.BlockTime(t)
+	expiration := start.Add(time.Hour)
+
+	_, waitAttest, err := c.EAS.Attest(ctx, r.UID, &eas.AttestOptions{ExpirationTime: expiration}, "hello")
+	assertNilError(t, err)
+	_, err = waitAttest(ctx)
+	assertNilError(t, err)
+
+	h.AdvanceTime(t, 2*time.Hour)
+
+	if got := h.BlockTime(t); got.Sub(start) < 2*time.Hour {
+		t.Errorf("got block time %s, want at least %s", got, start.Add(2*time.Hour))
+	}
+
+	// expiration time is now in the past
+	_, _, err = c.EAS.Attest(ctx, r.UID, &eas.AttestOptions{ExpirationTime: expiration}, "hello")
+	var contractErr *eas.ContractError
+	if !errors.As(err, &contractErr) || contractErr.Name != "InvalidExpirationTime" {
+		t.Errorf("got error %v, want InvalidExpirationTime", err)
+	}
+
+	_, waitTimestamp, err := c.EAS.Timestamp(ctx, eas.UID{1})
+	assertNilError(t, err)
+	timestamped, err := waitTimestamp(ctx)
+	assertNilError(t, err)
+	if got := timestamped.Timestamp.Time(); got.Sub(start) < 2*time.Hour {
+		t.Errorf("got timestamp %s, want at least %s", got, start.Add(2*time.Hour))
+	}
+}
+
+func TestHarness_HTTP(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, &eastest.Options{
+		AutoCommit: true,
+		HTTP:       true,
+	})
+
+	if !strings.HasPrefix(h.Endpoint(), "http://") {
+		t.Fatalf("got endpoint %q", h.Endpoint())
+	}
+
+	c, err := eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EAS, &eas.Options{
+		Backend: h.EthClient(),
+	})
+	assertNilError(t, err)
+
+	_, wait, err := c.SchemaRegistry.Register(ctx, "string message", common.Address{}, true)
+	assertNilError(t, err)
+	h.Commit()
+	r, err := wait(ctx)
+	assertNilError(t, err)
+
+	// the schema is registered on the simulated backend
+	s, err := h.Client().SchemaRegistry.GetSchema(ctx, r.UID)
+	assertNilError(t, err)
+	if s.Schema != "string message" {
+		t.Errorf("got schema %q, want %q", s.Schema, "string message")
+	}
+
+	if eastest.New(t, nil).EthClient() != nil {
+		t.Error("got client without the http option")
+	}
+}
+
+func TestHarness_Deploy(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, nil)
+	holder := h.Client().Address()
+
+	token := h.DeployToken(t, map[common.Address]*big.Int{
+		holder: big.NewInt(100),
+	})
+
+	resolverAddress := h.DeployTokenResolver(t, token, big.NewInt(100))
+
+	code, err := h.Simulated.Client().CodeAt(ctx, resolverAddress, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(code) == 0 {
+		t.Fatal("resolver not deployed")
+	}
+
+	_, wait, err := h.Client().SchemaRegistry.Register(ctx, "string message", resolverAddress, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.Commit()
+	r, err := wait(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, waitAttest, err := h.Client().EAS.Attest(ctx, r.UID, nil, "Hello!")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.Commit()
+	if _, err := waitAttest(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func assertNilError(t testing.TB, err error) {
+	t.Helper()
+
+	if err != nil {
+		t.Fatalf("got error %[1]T %[1]q", err)
+	}
+}
@@ -6,11 +6,15 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/rpc"
+
+	"resenje.org/eas/internal/contracts"
 )
 
 type Error struct {
@@ -26,6 +30,11 @@ func (e *Error) Error() string {
 type ContractError struct {
 	Name      string
 	Arguments []ContractErrorArgument
+
+	// id is the selector of the error and known reports if it is defined by
+	// EAS contracts or Solidity, and not by resolvers or other contracts.
+	id    [4]byte
+	known bool
 }
 
 func (e *ContractError) Error() string {
@@ -58,13 +67,107 @@ func (e *ContractError) Error() string {
 	return b.String()
 }
 
+// Is reports whether the target is a ContractError with the same name,
+// regardless of arguments, so that errors can be checked against exported
+// sentinel errors with errors.Is. Exported sentinel errors match only errors
+// with the same selector that are decoded with EAS contract ABIs, and not
+// errors of resolvers or other contracts with the same name.
+func (e *ContractError) Is(target error) bool {
+	t, ok := target.(*ContractError)
+	if !ok {
+		return false
+	}
+	if t.known {
+		return e.known && e.id == t.id
+	}
+	return t.Name == e.Name
+}
+
+// Errors defined by EAS, SchemaRegistry, EIP712Proxy and Indexer contracts
+// that can be checked with errors.Is.
+var (
+	ErrAccessDenied           = knownContractError("AccessDenied")
+	ErrAlreadyExists          = knownContractError("AlreadyExists")
+	ErrAlreadyRevoked         = knownContractError("AlreadyRevoked")
+	ErrAlreadyRevokedOffchain = knownContractError("AlreadyRevokedOffchain")
+	ErrAlreadyTimestamped     = knownContractError("AlreadyTimestamped")
+	ErrDeadlineExpired        = knownContractError("DeadlineExpired")
+	ErrInsufficientValue      = knownContractError("InsufficientValue")
+	ErrInvalidAttestation     = knownContractError("InvalidAttestation")
+	ErrInvalidAttestations    = knownContractError("InvalidAttestations")
+	ErrInvalidEAS             = knownContractError("InvalidEAS")
+	ErrInvalidExpirationTime  = knownContractError("InvalidExpirationTime")
+	ErrInvalidLength          = knownContractError("InvalidLength")
+	ErrInvalidOffset          = knownContractError("InvalidOffset")
+	ErrInvalidRegistry        = knownContractError("InvalidRegistry")
+	ErrInvalidRevocation      = knownContractError("InvalidRevocation")
+	ErrInvalidRevocations     = knownContractError("InvalidRevocations")
+	ErrInvalidSchema          = knownContractError("InvalidSchema")
+	ErrInvalidShortString     = knownContractError("InvalidShortString")
+	ErrInvalidSignature       = knownContractError("InvalidSignature")
+	ErrInvalidVerifier        = knownContractError("InvalidVerifier")
+	ErrIrrevocable            = knownContractError("Irrevocable")
+	ErrNotFound               = knownContractError("NotFound")
+	ErrNotPayable             = knownContractError("NotPayable")
+	ErrStringTooLong          = knownContractError("StringTooLong")
+	ErrUsedSignature          = knownContractError("UsedSignature")
+	ErrWrongSchema            = knownContractError("WrongSchema")
+)
+
+// Errors raised by Solidity require and revert statements with a message, and
+// by panics, such as failed assertions, with the panic code argument.
+var (
+	ErrRevert = knownContractError("Error")
+	ErrPanic  = knownContractError("Panic")
+)
+
+// knownErrorABIs are ABIs of EAS contracts, including the standard
+// SchemaResolver, and Solidity builtin errors, with errors that are matched
+// by exported sentinel errors.
+var knownErrorABIs = func() []*abi.ABI {
+	abis := []*abi.ABI{builtinErrorsABI}
+	for _, m := range []*bind.MetaData{
+		contracts.EASMetaData,
+		contracts.SchemaRegistryMetaData,
+		contracts.EIP712ProxyMetaData,
+		contracts.IndexerMetaData,
+		contracts.SchemaResolverMetaData,
+	} {
+		a, err := m.GetAbi()
+		if err != nil {
+			panic(err)
+		}
+		abis = append(abis, a)
+	}
+	return abis
+}()
+
+func isKnownErrorABI(a *abi.ABI) bool {
+	return slices.Contains(knownErrorABIs, a)
+}
+
+// knownContractError returns the sentinel error with the selector of the
+// error with the name defined in known error ABIs.
+func knownContractError(name string) *ContractError {
+	for _, a := range knownErrorABIs {
+		if e, ok := a.Errors[name]; ok {
+			return &ContractError{
+				Name:  name,
+				id:    [4]byte(e.ID[:4]),
+				known: true,
+			}
+		}
+	}
+	panic("unknown contract error " + name)
+}
+
 type ContractErrorArgument struct {
 	Name  string
 	Type  string
 	Value any
 }
 
-func unpackError(err error, abi *abi.ABI) error {
+func unpackError(err error, abis ...*abi.ABI) error {
 	{
 		var e rpc.DataError
 		if errors.As(err, &e) {
@@ -75,13 +178,13 @@ func unpackError(err error, abi *abi.ABI) error {
 				if decodeErr != nil {
 					return fmt.Errorf("%w: %w", err, decodeErr)
 				}
-				contractErr := unpackErrorData(abi, b)
+				contractErr := unpackErrorData(abis, b)
 				if contractErr != nil {
 					return fmt.Errorf("%w: %w", err, contractErr)
 				}
 				return err
 			case []byte:
-				contractErr := unpackErrorData(abi, data)
+				contractErr := unpackErrorData(abis, data)
 				if contractErr != nil {
 					return fmt.Errorf("%w: %w", err, contractErr)
 				}
@@ -122,7 +225,33 @@ func unpackError(err error, abi *abi.ABI) error {
 	return err
 }
 
-func unpackErrorData(abi *abi.ABI, data []byte) *ContractError {
+// builtinErrorsABI defines errors that Solidity uses for require and revert
+// statements with a message and for panics.
+var builtinErrorsABI = func() *abi.ABI {
+	a, err := abi.JSON(strings.NewReader(`[
+		{"type": "error", "name": "Error", "inputs": [{"name": "message", "type": "string"}]},
+		{"type": "error", "name": "Panic", "inputs": [{"name": "code", "type": "uint256"}]}
+	]`))
+	if err != nil {
+		panic(err)
+	}
+	return &a
+}()
+
+func unpackErrorData(abis []*abi.ABI, data []byte) *ContractError {
+	if len(data) < 4 {
+		return nil
+	}
+
+	for _, a := range abis {
+		if contractErr := unpackABIErrorData(a, data); contractErr != nil {
+			return contractErr
+		}
+	}
+	return unpackABIErrorData(builtinErrorsABI, data)
+}
+
+func unpackABIErrorData(abi *abi.ABI, data []byte) *ContractError {
 	abiError, err := abi.ErrorByID([4]byte(data[:4]))
 	if abiError == nil || err != nil {
 		return nil
@@ -137,6 +266,8 @@ func unpackErrorData(abi *abi.ABI, data []byte) *ContractError {
 		output := ContractError{
 			Name:      abiError.Name,
 			Arguments: make([]ContractErrorArgument, 0, len(abiError.Inputs)),
+			id:        [4]byte(abiError.ID[:4]),
+			known:     isKnownErrorABI(abi),
 		}
 		for i, input := range abiError.Inputs {
 			output.Arguments = append(output.Arguments, ContractErrorArgument{
@@ -0,0 +1,278 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"resenje.org/eas"
+	"resenje.org/eas/eastest"
+	"resenje.org/eas/internal/contracts"
+)
+
+func TestContractError_Is(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, &eastest.Options{AutoCommit: true})
+	c := h.Client()
+
+	_, wait, err := c.SchemaRegistry.Register(ctx, "string message", common.Address{}, false)
+	assertNilError(t, err)
+	r, err := wait(ctx)
+	assertNilError(t, err)
+
+	_, _, err = c.SchemaRegistry.Register(ctx, "string message", common.Address{}, false)
+	assertErrorIs(t, err, eas.ErrAlreadyExists)
+
+	_, waitAttest, err := c.EAS.Attest(ctx, r.UID, nil, "hello")
+	assertNilError(t, err)
+	a, err := waitAttest(ctx)
+	assertNilError(t, err)
+
+	_, _, err = c.EAS.Revoke(ctx, r.UID, a.UID, nil)
+	assertErrorIs(t, err, eas.ErrIrrevocable)
+
+	_, _, err = c.EAS.Revoke(ctx, r.UID, eas.UID{1}, nil)
+	assertErrorIs(t, err, eas.ErrNotFound)
+
+	_, _, err = c.EAS.Attest(ctx, eas.UID{1}, nil, "hello")
+	assertErrorIs(t, err, eas.ErrInvalidSchema)
+
+	if errors.Is(err, eas.ErrNotFound) {
+		t.Error("error matches sentinel error with a different name")
+	}
+}
+
+func TestContractError_sentinels(t *testing.T) {
+	sentinels := map[string]error{
+		"AccessDenied":           eas.ErrAccessDenied,
+		"AlreadyExists":          eas.ErrAlreadyExists,
+		"AlreadyRevoked":         eas.ErrAlreadyRevoked,
+		"AlreadyRevokedOffchain": eas.ErrAlreadyRevokedOffchain,
+		"AlreadyTimestamped":     eas.ErrAlreadyTimestamped,
+		"DeadlineExpired":        eas.ErrDeadlineExpired,
+		"InsufficientValue":      eas.ErrInsufficientValue,
+		"InvalidAttestation":     eas.ErrInvalidAttestation,
+		"InvalidAttestations":    eas.ErrInvalidAttestations,
+		"InvalidEAS":             eas.ErrInvalidEAS,
+		"InvalidExpirationTime":  eas.ErrInvalidExpirationTime,
+		"InvalidLength":          eas.ErrInvalidLength,
+		"InvalidOffset":          eas.ErrInvalidOffset,
+		"InvalidRegistry":        eas.ErrInvalidRegistry,
+		"InvalidRevocation":      eas.ErrInvalidRevocation,
+		"InvalidRevocations":     eas.ErrInvalidRevocations,
+		"InvalidSchema":          eas.ErrInvalidSchema,
+		"InvalidShortString":     eas.ErrInvalidShortString,
+		"InvalidSignature":       eas.ErrInvalidSignature,
+		"InvalidVerifier":        eas.ErrInvalidVerifier,
+		"Irrevocable":            eas.ErrIrrevocable,
+		"NotFound":               eas.ErrNotFound,
+		"NotPayable":             eas.ErrNotPayable,
+		"StringTooLong":          eas.ErrStringTooLong,
+		"UsedSignature":          eas.ErrUsedSignature,
+		"WrongSchema":            eas.ErrWrongSchema,
+	}
+
+	h := eastest.New(t, &eastest.Options{Faults: true})
+
+	for _, metaData := range []*bind.MetaData{contracts.EASMetaData, contracts.SchemaRegistryMetaData, contracts.EIP712ProxyMetaData, contracts.IndexerMetaData} {
+		a, err := metaData.GetAbi()
+		assertNilError(t, err)
+
+		for name, abiError := range a.Errors {
+			t.Run(name, func(t *testing.T) {
+				sentinel, ok := sentinels[name]
+				if !ok {
+					t.Fatalf("no sentinel error for %s", name)
+				}
+
+				data, err := abiErrorData(abiError)
+				assertNilError(t, err)
+
+				h.Faults.Reset()
+				h.Faults.Inject(eastest.Fault{
+					Err: eastest.RevertError(data),
+				})
+
+				switch metaData {
+				case contracts.SchemaRegistryMetaData:
+					_, err = h.Client().SchemaRegistry.GetSchema(context.Background(), eas.UID{1})
+				case contracts.EIP712ProxyMetaData:
+					_, err = h.Client().EIP712Proxy.GetAttester(context.Background(), eas.UID{1})
+				case contracts.IndexerMetaData:
+					_, err = h.Client().Indexer.IsAttestationIndexed(context.Background(), eas.UID{1})
+				default:
+					_, err = h.Client().EAS.GetAttestation(context.Background(), eas.UID{1})
+				}
+				assertErrorIs(t, err, sentinel)
+			})
+		}
+	}
+}
+
+func TestContractError_shortData(t *testing.T) {
+	h := eastest.New(t, &eastest.Options{Faults: true})
+
+	h.Faults.Inject(eastest.Fault{
+		Err: eastest.RevertError([]byte{1, 2}),
+	})
+
+	_, err := h.Client().EAS.GetAttestation(context.Background(), eas.UID{1})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	var contractErr *eas.ContractError
+	if errors.As(err, &contractErr) {
+		t.Errorf("got contract error %v", contractErr)
+	}
+}
+
+func TestContractError_sameName(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, &eastest.Options{Faults: true})
+
+	customABI, err := abi.JSON(strings.NewReader(`[
+		{"type": "error", "name": "InvalidAttestation", "inputs": [{"name": "reason", "type": "string"}]}
+	]`))
+	assertNilError(t, err)
+
+	c, err := eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EAS, &eas.Options{
+		Backend:   h.Backend(),
+		ErrorABIs: []*abi.ABI{&customABI},
+	})
+	assertNilError(t, err)
+
+	data, err := abiErrorData(customABI.Errors["InvalidAttestation"])
+	assertNilError(t, err)
+	h.Faults.Inject(eastest.Fault{
+		Err: eastest.RevertError(data),
+	})
+
+	_, err = c.EAS.GetAttestation(ctx, eas.UID{1})
+	if errors.Is(err, eas.ErrInvalidAttestation) {
+		t.Error("custom error matches sentinel error with the same name")
+	}
+	assertErrorIs(t, err, &eas.ContractError{Name: "InvalidAttestation"})
+}
+
+func TestContractError_resolver(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, &eastest.Options{AutoCommit: true})
+	c := h.Client()
+
+	txOpts, err := bind.NewKeyedTransactorWithChainID(h.Accounts[0].PrivateKey, big.NewInt(1337))
+	assertNilError(t, err)
+
+	resolverAddress, tx, _, err := contracts.DeployRevertingResolver(txOpts, h.Backend())
+	assertNilError(t, err)
+	_, err = bind.WaitDeployed(ctx, h.Backend(), tx)
+	assertNilError(t, err)
+
+	_, wait, err := c.SchemaRegistry.Register(ctx, "bytes1 mode", resolverAddress, true)
+	assertNilError(t, err)
+	r, err := wait(ctx)
+	assertNilError(t, err)
+
+	_, waitAttest, err := c.EAS.Attest(ctx, r.UID, &eas.AttestOptions{Revocable: true}, eas.EncodedData{})
+	assertNilError(t, err)
+	a, err := waitAttest(ctx)
+	assertNilError(t, err)
+
+	// custom resolver error without registered abi
+	_, _, err = c.EAS.Attest(ctx, r.UID, nil, eas.EncodedData{0})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	var contractErr *eas.ContractError
+	if errors.As(err, &contractErr) {
+		t.Errorf("got contract error %v", contractErr)
+	}
+
+	// builtin errors
+	_, _, err = c.EAS.Attest(ctx, r.UID, nil, eas.EncodedData{1})
+	assertErrorIs(t, err, eas.ErrRevert)
+	if !errors.As(err, &contractErr) {
+		t.Fatalf("got error %v, want contract error", err)
+	}
+	assertEqual(t, "message", contractErr.Arguments[0].Value, any("rejected with message"))
+
+	_, _, err = c.EAS.Attest(ctx, r.UID, nil, eas.EncodedData{2})
+	assertErrorIs(t, err, eas.ErrPanic)
+	if !errors.As(err, &contractErr) {
+		t.Fatalf("got error %v, want contract error", err)
+	}
+	assertEqual(t, "panic code", contractErr.Arguments[0].Value.(*big.Int).Int64(), 1)
+
+	resolverABI, err := contracts.RevertingResolverMetaData.GetAbi()
+	assertNilError(t, err)
+	rejected := &eas.ContractError{Name: "Rejected"}
+
+	// registered resolver abi
+	c.RegisterResolverErrorABI(resolverAddress, resolverABI)
+
+	_, _, err = c.EAS.Attest(ctx, r.UID, nil, eas.EncodedData{0})
+	assertErrorIs(t, err, rejected)
+	if !errors.As(err, &contractErr) {
+		t.Fatalf("got error %v, want contract error", err)
+	}
+	assertEqual(t, "reason", contractErr.Arguments[1].Value, any("rejected"))
+
+	_, _, err = c.EAS.MultiAttest(ctx, r.UID, nil, []any{eas.EncodedData{0}})
+	assertErrorIs(t, err, rejected)
+
+	_, _, err = c.EAS.Revoke(ctx, r.UID, a.UID, nil)
+	assertErrorIs(t, err, rejected)
+	if !errors.As(err, &contractErr) {
+		t.Fatalf("got error %v, want contract error", err)
+	}
+	assertEqual(t, "uid", eas.UID(contractErr.Arguments[0].Value.([32]byte)), a.UID)
+	assertEqual(t, "reason", contractErr.Arguments[1].Value, any("irrevocable"))
+
+	// globally registered abi
+	global, err := eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EAS, &eas.Options{
+		Backend:   h.Backend(),
+		ErrorABIs: []*abi.ABI{resolverABI},
+	})
+	assertNilError(t, err)
+
+	_, _, err = global.EAS.Revoke(ctx, r.UID, a.UID, nil)
+	assertErrorIs(t, err, rejected)
+}
+
+func abiErrorData(e abi.Error) ([]byte, error) {
+	args := make([]any, 0, len(e.Inputs))
+	for _, input := range e.Inputs {
+		switch input.Type.T {
+		case abi.StringTy:
+			args = append(args, "value")
+		default:
+			return nil, errors.New("unsupported argument type " + input.Type.String())
+		}
+	}
+	data, err := e.Inputs.Pack(args...)
+	if err != nil {
+		return nil, err
+	}
+	return append(e.ID[:4:4], data...), nil
+}
+
+func assertErrorIs(t testing.TB, err, target error) {
+	t.Helper()
+
+	if !errors.Is(err, target) {
+		t.Errorf("got error %v, want %v", err, target)
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Estimate is the estimated cost of a transaction.
+type Estimate struct {
+	// Gas is the estimated amount of gas used by the transaction.
+	Gas uint64
+	// BaseFee is the base fee of the latest block, nil on chains without
+	// dynamic fees.
+	BaseFee *big.Int
+	// GasTipCap is the priority fee per gas.
+	GasTipCap *big.Int
+	// GasFeeCap is the maximal fee per gas.
+	GasFeeCap *big.Int
+	// GasPrice is the expected price per gas, the sum of the base fee and the
+	// tip limited by the fee cap, or the suggested gas price on chains
+	// without dynamic fees.
+	GasPrice *big.Int
+	// Fee is the expected transaction fee, the product of Gas and GasPrice.
+	Fee *big.Int
+	// MaxFee is the maximal transaction fee, the product of Gas and
+	// GasFeeCap.
+	MaxFee *big.Int
+	// Value is sent with the transaction and forwarded to schema resolvers.
+	Value *big.Int
+}
+
+// Total returns the expected total cost of the transaction, the sum of the fee
+// and the value.
+func (e *Estimate) Total() *big.Int {
+	return new(big.Int).Add(e.Fee, e.Value)
+}
+
+func (c *Client) estimate(ctx context.Context, contract common.Address, a *abi.ABI, value *big.Int, method string, args ...any) (*Estimate, error) {
+	msg, err := c.newCallMsg(contract, a, value, method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	gas, err := c.backend.EstimateGas(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if value == nil {
+		value = big.NewInt(0)
+	}
+	e := &Estimate{
+		Gas:   gas,
+		Value: value,
+	}
+
+	header, err := c.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("get latest header: %w", err)
+	}
+
+	if header.BaseFee == nil {
+		gasPrice, err := c.backend.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("suggest gas price: %w", err)
+		}
+		e.GasPrice = gasPrice
+		e.GasFeeCap = gasPrice
+		e.GasTipCap = gasPrice
+	} else {
+		e.BaseFee = header.BaseFee
+
+		e.GasTipCap = c.options.GasTipCap
+		if e.GasTipCap == nil {
+			tip, err := c.backend.SuggestGasTipCap(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("suggest gas tip cap: %w", err)
+			}
+			e.GasTipCap = tip
+		}
+
+		// the same default fee cap as in transactions sent by the client
+		e.GasFeeCap = c.options.GasFeeCap
+		if e.GasFeeCap == nil {
+			e.GasFeeCap = new(big.Int).Add(e.GasTipCap, new(big.Int).Mul(header.BaseFee, big.NewInt(2)))
+		}
+
+		e.GasPrice = new(big.Int).Add(header.BaseFee, e.GasTipCap)
+		if e.GasPrice.Cmp(e.GasFeeCap) > 0 {
+			e.GasPrice = new(big.Int).Set(e.GasFeeCap)
+		}
+	}
+
+	gasBig := new(big.Int).SetUint64(gas)
+	e.Fee = new(big.Int).Mul(gasBig, e.GasPrice)
+	e.MaxFee = new(big.Int).Mul(gasBig, e.GasFeeCap)
+
+	return e, nil
+}
+
+func (c *Client) newCallMsg(contract common.Address, a *abi.ABI, value *big.Int, method string, args ...any) (ethereum.CallMsg, error) {
+	data, err := a.Pack(method, args...)
+	if err != nil {
+		return ethereum.CallMsg{}, fmt.Errorf("pack arguments: %w", err)
+	}
+
+	return ethereum.CallMsg{
+		From:  c.account,
+		To:    &contract,
+		Value: value,
+		Data:  data,
+	}, nil
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"resenje.org/eas"
+	"resenje.org/eas/eastest"
+)
+
+func TestEASContract_EstimateAttest(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	schemaUID := registerSchema(t, client, "string message")
+
+	e, err := client.EAS.EstimateAttest(ctx, schemaUID, &eas.AttestOptions{Revocable: true}, "Hello!")
+	assertNilError(t, err)
+
+	if e.BaseFee == nil {
+		t.Fatal("base fee not set")
+	}
+	assertEqual(t, "gas price", e.GasPrice.Cmp(new(big.Int).Add(e.BaseFee, e.GasTipCap)), 0)
+	assertEqual(t, "fee", e.Fee.Cmp(new(big.Int).Mul(new(big.Int).SetUint64(e.Gas), e.GasPrice)), 0)
+	assertEqual(t, "max fee", e.MaxFee.Cmp(new(big.Int).Mul(new(big.Int).SetUint64(e.Gas), e.GasFeeCap)), 0)
+	assertEqual(t, "value", e.Value.Sign(), 0)
+	assertEqual(t, "total", e.Total().Cmp(e.Fee), 0)
+
+	tx, wait, err := client.EAS.Attest(ctx, schemaUID, &eas.AttestOptions{Revocable: true}, "Hello!")
+	assertNilError(t, err)
+
+	client.backend.Commit()
+
+	_, err = wait(ctx)
+	assertNilError(t, err)
+
+	receipt, err := client.backend.Client().TransactionReceipt(ctx, tx.Hash())
+	assertNilError(t, err)
+	if receipt.GasUsed > e.Gas {
+		t.Errorf("got used gas %v greater than estimated %v", receipt.GasUsed, e.Gas)
+	}
+
+	_, err = client.EAS.EstimateAttest(ctx, schemaUID, &eas.AttestOptions{Value: big.NewInt(1)}, "Hello!")
+	assertErrorIs(t, err, eas.ErrNotPayable)
+}
+
+func TestEASContract_Estimate(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	schemaUID := registerSchema(t, client, "string message")
+	uid := attest(t, client, schemaUID, &eas.AttestOptions{Revocable: true}, "Hello!")
+
+	for _, tc := range []struct {
+		name     string
+		estimate func() (*eas.Estimate, error)
+	}{
+		{
+			name: "multi attest",
+			estimate: func() (*eas.Estimate, error) {
+				return client.EAS.EstimateMultiAttest(ctx, schemaUID, nil, []any{"one"}, []any{"two"})
+			},
+		},
+		{
+			name: "revoke",
+			estimate: func() (*eas.Estimate, error) {
+				return client.EAS.EstimateRevoke(ctx, schemaUID, uid, nil)
+			},
+		},
+		{
+			name: "timestamp",
+			estimate: func() (*eas.Estimate, error) {
+				return client.EAS.EstimateTimestamp(ctx, eas.UID{1})
+			},
+		},
+		{
+			name: "register",
+			estimate: func() (*eas.Estimate, error) {
+				return client.SchemaRegistry.EstimateRegister(ctx, "string name", common.Address{}, true)
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			e, err := tc.estimate()
+			assertNilError(t, err)
+			if e.Gas == 0 {
+				t.Error("zero gas")
+			}
+			if e.Fee.Sign() <= 0 {
+				t.Error("zero fee")
+			}
+		})
+	}
+
+	_, err := client.EAS.EstimateRevoke(ctx, schemaUID, eas.UID{1}, nil)
+	assertErrorIs(t, err, eas.ErrNotFound)
+}
+
+func TestEASContract_Estimate_options(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, nil)
+
+	gasTipCap := big.NewInt(2_000_000_000)
+	gasFeeCap := big.NewInt(3_000_000_000)
+
+	c, err := eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EAS, &eas.Options{
+		Backend:   h.Backend(),
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+	})
+	assertNilError(t, err)
+
+	e, err := c.EAS.EstimateTimestamp(ctx, eas.UID{1})
+	assertNilError(t, err)
+
+	assertEqual(t, "gas tip cap", e.GasTipCap.Cmp(gasTipCap), 0)
+	assertEqual(t, "gas fee cap", e.GasFeeCap.Cmp(gasFeeCap), 0)
+	if e.GasPrice.Cmp(gasFeeCap) > 0 {
+		t.Errorf("got gas price %v greater than fee cap %v", e.GasPrice, gasFeeCap)
+	}
+}
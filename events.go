@@ -0,0 +1,216 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// EventType is the type of EAS and SchemaRegistry contract events.
+type EventType int
+
+const (
+	EventAttested EventType = iota + 1
+	EventRevoked
+	EventTimestamped
+	EventRevokedOffchain
+	EventRegistered
+)
+
+var eventTypes = []EventType{
+	EventAttested,
+	EventRevoked,
+	EventTimestamped,
+	EventRevokedOffchain,
+	EventRegistered,
+}
+
+func (t EventType) String() string {
+	switch t {
+	case EventAttested:
+		return "Attested"
+	case EventRevoked:
+		return "Revoked"
+	case EventTimestamped:
+		return "Timestamped"
+	case EventRevokedOffchain:
+		return "RevokedOffchain"
+	case EventRegistered:
+		return "Registered"
+	}
+	return fmt.Sprintf("EventType(%d)", int(t))
+}
+
+// Event is one of EAS and SchemaRegistry contract events. Only the field of
+// the event type is set.
+type Event struct {
+	Type            EventType
+	Attested        *EASAttested
+	Revoked         *EASRevoked
+	Timestamped     *EASTimestamped
+	RevokedOffchain *EASRevokedOffchain
+	Registered      *SchemaRegistryRegistered
+	Raw             types.Log
+}
+
+// EventFilter selects events of FilterEvents, WatchEvents and StreamEvents
+// methods. All events are selected by a single logs query, so recipients,
+// attesters and schemas can be filtered only for Attested and Revoked events,
+// which have them as the same indexed arguments.
+type EventFilter struct {
+	// Types of selected events. If empty, all events are selected, or only
+	// Attested and Revoked events if recipients, attesters or schemas are
+	// set.
+	Types      []EventType
+	Recipients []common.Address
+	Attesters  []common.Address
+	Schemas    []UID
+}
+
+// FilterEvents returns an iterator over EAS and SchemaRegistry events ordered
+// by block and log index.
+func (c *Client) FilterEvents(ctx context.Context, start uint64, end *uint64, f *EventFilter) (Iterator[Event], error) {
+	query, err := c.eventsQuery(f)
+	if err != nil {
+		return nil, err
+	}
+	return newQueryIterator(ctx, c, query, c.filterStart(start), end, c.parseEvent)
+}
+
+// WatchEvents sends EAS and SchemaRegistry events to the sink ordered by block
+// and log index, in the same way as Watch methods of contracts.
+func (c *Client) WatchEvents(ctx context.Context, start *uint64, sink chan<- Event, f *EventFilter) (event.Subscription, error) {
+	query, err := c.eventsQuery(f)
+	if err != nil {
+		return nil, err
+	}
+	return watchQuery(ctx, c, query, start, c.parseEvent, sink)
+}
+
+// StreamEvents sends added and removed EAS and SchemaRegistry events to the
+// sink, in the same way as Stream methods of contracts.
+func (c *Client) StreamEvents(ctx context.Context, o *StreamOptions, sink chan<- StreamEvent[Event], f *EventFilter) (event.Subscription, error) {
+	query, err := c.eventsQuery(f)
+	if err != nil {
+		return nil, err
+	}
+	return streamQuery(ctx, c, query, o, c.parseEvent, sink)
+}
+
+// eventsQuery returns a single logs query of all events selected by the
+// filter.
+func (c *Client) eventsQuery(f *EventFilter) (ethereum.FilterQuery, error) {
+	if f == nil {
+		f = new(EventFilter)
+	}
+
+	hasArguments := len(f.Recipients) > 0 || len(f.Attesters) > 0 || len(f.Schemas) > 0
+
+	selected := f.Types
+	if len(selected) == 0 {
+		if hasArguments {
+			selected = []EventType{EventAttested, EventRevoked}
+		} else {
+			selected = eventTypes
+		}
+	}
+
+	var easEvents, registryEvents bool
+	ids := make([]any, 0, len(selected))
+	for _, t := range selected {
+		e, ok := c.eventABI(t)
+		if !ok {
+			return ethereum.FilterQuery{}, fmt.Errorf("unknown event type %v", t)
+		}
+		if hasArguments && t != EventAttested && t != EventRevoked {
+			return ethereum.FilterQuery{}, fmt.Errorf("recipients, attesters and schemas can not be filtered for %s events", t)
+		}
+		if t == EventRegistered {
+			registryEvents = true
+		} else {
+			easEvents = true
+		}
+		ids = append(ids, e.ID)
+	}
+
+	var addresses []common.Address
+	if easEvents {
+		addresses = append(addresses, c.easContractAddress)
+	}
+	if registryEvents {
+		addresses = append(addresses, c.SchemaRegistry.address)
+	}
+
+	topics, err := abi.MakeTopics(ids, filterRule(f.Recipients), filterRule(f.Attesters), filterRule(castUIDSlice(f.Schemas)))
+	if err != nil {
+		return ethereum.FilterQuery{}, fmt.Errorf("make topics: %w", err)
+	}
+	// logs with fewer topics than the query are not matched, even if trailing
+	// topics are wildcards
+	for len(topics) > 1 && len(topics[len(topics)-1]) == 0 {
+		topics = topics[:len(topics)-1]
+	}
+
+	return ethereum.FilterQuery{
+		Addresses: addresses,
+		Topics:    topics,
+	}, nil
+}
+
+func (c *Client) eventABI(t EventType) (abi.Event, bool) {
+	var e abi.Event
+	var ok bool
+	switch t {
+	case EventAttested, EventRevoked, EventTimestamped, EventRevokedOffchain:
+		e, ok = c.EAS.abi.Events[t.String()]
+	case EventRegistered:
+		e, ok = c.SchemaRegistry.registeredEvent, true
+	}
+	return e, ok
+}
+
+// parseEvent parses the log of any EAS or SchemaRegistry event.
+func (c *Client) parseEvent(l types.Log) (Event, error) {
+	if len(l.Topics) == 0 {
+		return Event{}, errors.New("log without topics")
+	}
+
+	for _, t := range eventTypes {
+		e, _ := c.eventABI(t)
+		if l.Topics[0] != e.ID {
+			continue
+		}
+
+		v := Event{Type: t, Raw: l}
+		var err error
+		switch t {
+		case EventAttested:
+			v.Attested, err = newParseProxy(c.EAS.contract.ParseAttested, newEASAttested)(l)
+		case EventRevoked:
+			v.Revoked, err = newParseProxy(c.EAS.contract.ParseRevoked, newEASRevoked)(l)
+		case EventTimestamped:
+			v.Timestamped, err = newParseProxy(c.EAS.contract.ParseTimestamped, newEASTimestamped)(l)
+		case EventRevokedOffchain:
+			v.RevokedOffchain, err = newParseProxy(c.EAS.contract.ParseRevokedOffchain, newEASRevokedOffchain)(l)
+		case EventRegistered:
+			v.Registered, err = c.SchemaRegistry.parseRegistered(l)
+		}
+		if err != nil {
+			return Event{}, fmt.Errorf("parse %s event: %w", t, err)
+		}
+		return v, nil
+	}
+
+	return Event{}, fmt.Errorf("unknown event %s", l.Topics[0])
+}
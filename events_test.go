@@ -0,0 +1,170 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"resenje.org/eas"
+	"resenje.org/eas/eastest"
+)
+
+func TestClient_FilterEvents(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, &eastest.Options{AutoCommit: true})
+	c := h.Client()
+
+	_, wait, err := c.SchemaRegistry.Register(ctx, "string message", [20]byte{}, true)
+	assertNilError(t, err)
+	registered, err := wait(ctx)
+	assertNilError(t, err)
+	schemaUID := registered.UID
+
+	_, wait, err = c.SchemaRegistry.Register(ctx, "uint256 count", [20]byte{}, true)
+	assertNilError(t, err)
+	otherRegistered, err := wait(ctx)
+	assertNilError(t, err)
+
+	_, waitAttest, err := c.EAS.Attest(ctx, schemaUID, &eas.AttestOptions{Revocable: true}, "Hello!")
+	assertNilError(t, err)
+	attested, err := waitAttest(ctx)
+	assertNilError(t, err)
+
+	_, waitAttest, err = c.EAS.Attest(ctx, otherRegistered.UID, nil, big.NewInt(1))
+	assertNilError(t, err)
+	_, err = waitAttest(ctx)
+	assertNilError(t, err)
+
+	_, waitTimestamp, err := c.EAS.Timestamp(ctx, attested.UID)
+	assertNilError(t, err)
+	_, err = waitTimestamp(ctx)
+	assertNilError(t, err)
+
+	_, waitRevokeOffchain, err := c.EAS.RevokeOffchain(ctx, attested.UID)
+	assertNilError(t, err)
+	_, err = waitRevokeOffchain(ctx)
+	assertNilError(t, err)
+
+	_, waitRevoke, err := c.EAS.Revoke(ctx, schemaUID, attested.UID, nil)
+	assertNilError(t, err)
+	revoked, err := waitRevoke(ctx)
+	assertNilError(t, err)
+
+	filter := func(t *testing.T, f *eas.EventFilter) []eas.Event {
+		t.Helper()
+
+		it, err := c.FilterEvents(ctx, 0, nil, f)
+		assertNilError(t, err)
+		defer it.Close()
+
+		var events []eas.Event
+		for it.Next() {
+			events = append(events, it.Value())
+		}
+		assertNilError(t, it.Error())
+		return events
+	}
+
+	eventTypes := func(events []eas.Event) []eas.EventType {
+		var types []eas.EventType
+		for _, e := range events {
+			types = append(types, e.Type)
+		}
+		return types
+	}
+
+	t.Run("all", func(t *testing.T) {
+		events := filter(t, nil)
+
+		assertEqual(t, "types", eventTypes(events), []eas.EventType{
+			eas.EventRegistered,
+			eas.EventRegistered,
+			eas.EventAttested,
+			eas.EventAttested,
+			eas.EventTimestamped,
+			eas.EventRevokedOffchain,
+			eas.EventRevoked,
+		})
+
+		for i := 1; i < len(events); i++ {
+			prev, cur := events[i-1].Raw, events[i].Raw
+			if cur.BlockNumber < prev.BlockNumber || cur.BlockNumber == prev.BlockNumber && cur.Index <= prev.Index {
+				t.Errorf("event %v is not ordered", i)
+			}
+		}
+
+		assertEqual(t, "registered", events[0].Registered.UID, schemaUID)
+		assertEqual(t, "attested", events[2].Attested.UID, attested.UID)
+		assertEqual(t, "timestamped", events[4].Timestamped.Data, attested.UID)
+		assertEqual(t, "revoked offchain", events[5].RevokedOffchain.Data, attested.UID)
+		assertEqual(t, "revoked", events[6].Revoked.UID, revoked.UID)
+		if events[6].Attested != nil {
+			t.Error("attested field set on revoked event")
+		}
+	})
+
+	t.Run("types", func(t *testing.T) {
+		events := filter(t, &eas.EventFilter{
+			Types: []eas.EventType{eas.EventRegistered, eas.EventTimestamped},
+		})
+
+		assertEqual(t, "types", eventTypes(events), []eas.EventType{
+			eas.EventRegistered,
+			eas.EventRegistered,
+			eas.EventTimestamped,
+		})
+	})
+
+	t.Run("schemas", func(t *testing.T) {
+		events := filter(t, &eas.EventFilter{
+			Schemas: []eas.UID{schemaUID},
+		})
+
+		assertEqual(t, "types", eventTypes(events), []eas.EventType{
+			eas.EventAttested,
+			eas.EventRevoked,
+		})
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		_, err := c.FilterEvents(ctx, 0, nil, &eas.EventFilter{
+			Types:   []eas.EventType{eas.EventTimestamped},
+			Schemas: []eas.UID{schemaUID},
+		})
+		if err == nil {
+			t.Fatal("expected error")
+		}
+	})
+
+	t.Run("watch", func(t *testing.T) {
+		start := uint64(0)
+		sink := make(chan eas.Event)
+		sub, err := c.WatchEvents(ctx, &start, sink, &eas.EventFilter{
+			Types: []eas.EventType{eas.EventRegistered, eas.EventRevoked},
+		})
+		assertNilError(t, err)
+		defer sub.Unsubscribe()
+
+		var types []eas.EventType
+		for range 3 {
+			select {
+			case e := <-sink:
+				types = append(types, e.Type)
+			case <-time.After(5 * time.Second):
+				t.Fatal("timeout")
+			}
+		}
+		assertEqual(t, "types", types, []eas.EventType{
+			eas.EventRegistered,
+			eas.EventRegistered,
+			eas.EventRevoked,
+		})
+	})
+}
@@ -0,0 +1,504 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+var (
+	// ErrChainIDMismatch is returned if failover backend endpoints are
+	// connected to different chains.
+	ErrChainIDMismatch = errors.New("chain id mismatch")
+	// ErrNoQuorum is returned by quorum reads if not enough endpoints return
+	// the same result.
+	ErrNoQuorum = errors.New("no quorum")
+)
+
+// FailoverOptions configure FailoverBackend.
+type FailoverOptions struct {
+	// Cooldown is the duration for which an endpoint that failed is tried
+	// only after all healthy endpoints. Default is 30s.
+	Cooldown time.Duration
+	// HealthCheckInterval enables periodic checks of all endpoints, which
+	// verify that they are reachable and connected to the same chain. The
+	// Close method stops health checks.
+	HealthCheckInterval time.Duration
+	// Quorum is the number of endpoints that have to return the same result
+	// of contract calls, such as GetAttestation or GetSchema. Calls without
+	// the block number are executed at the latest block of one endpoint. If
+	// not greater than one, contract calls are sent to one endpoint.
+	Quorum int
+	// IsFailover, if set, decides if the error is caused by the endpoint and
+	// the call should be sent to the next one. By default, errors that are
+	// retryable by the default RetryPolicy cause failover.
+	IsFailover func(err error) bool
+}
+
+// EndpointStatus is the health of a FailoverBackend endpoint.
+type EndpointStatus struct {
+	Healthy bool
+	// LastError is the last error that marked the endpoint as unhealthy.
+	LastError error
+	// LastFailure is the time when the endpoint was marked as unhealthy.
+	LastFailure time.Time
+}
+
+// FailoverBackend sends calls to multiple endpoints of the same chain, in the
+// order they are provided, and fails over to the next endpoint when an
+// endpoint returns an error that is not a result of the call itself, such as
+// a network error or an HTTP 5xx response. Reverts and other call errors are
+// returned without failover.
+type FailoverBackend struct {
+	endpoints []*endpoint
+	chainID   *big.Int
+	options   FailoverOptions
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+	once sync.Once
+}
+
+var _ Backend = (*FailoverBackend)(nil)
+
+type endpoint struct {
+	index   int
+	backend Backend
+
+	mu     sync.Mutex
+	status EndpointStatus
+	// verified is set when the endpoint chain id is checked.
+	verified bool
+}
+
+func (e *endpoint) markHealthy() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.status.Healthy = true
+}
+
+func (e *endpoint) markVerified() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.verified = true
+	e.status.Healthy = true
+}
+
+func (e *endpoint) isVerified() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.verified
+}
+
+func (e *endpoint) markUnhealthy(err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.status = EndpointStatus{
+		Healthy:     false,
+		LastError:   err,
+		LastFailure: time.Now(),
+	}
+}
+
+func (e *endpoint) getStatus() EndpointStatus {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.status
+}
+
+// NewFailoverBackend returns a FailoverBackend for the provided backends. It
+// returns ErrChainIDMismatch if backends are not connected to the same chain.
+// Unavailable backends are marked as unhealthy and their chain id is checked
+// before they are called, but at least one backend has to be available.
+func NewFailoverBackend(ctx context.Context, backends []Backend, o *FailoverOptions) (*FailoverBackend, error) {
+	if len(backends) == 0 {
+		return nil, errors.New("no backends")
+	}
+
+	if o == nil {
+		o = new(FailoverOptions)
+	}
+	options := *o
+	if options.Cooldown <= 0 {
+		options.Cooldown = 30 * time.Second
+	}
+	if options.IsFailover == nil {
+		options.IsFailover = RetryPolicy{}.IsRetryable
+	}
+	if options.Quorum > len(backends) {
+		return nil, fmt.Errorf("quorum %v greater than the number of backends %v", options.Quorum, len(backends))
+	}
+
+	b := &FailoverBackend{
+		options: options,
+		quit:    make(chan struct{}),
+	}
+
+	var errs []error
+	for i, backend := range backends {
+		e := &endpoint{
+			index:   i,
+			backend: backend,
+		}
+		b.endpoints = append(b.endpoints, e)
+
+		chainID, err := backend.ChainID(ctx)
+		if err != nil {
+			if ctx.Err() != nil || !options.IsFailover(err) {
+				return nil, fmt.Errorf("get chain id of endpoint %v: %w", i, err)
+			}
+			e.markUnhealthy(err)
+			errs = append(errs, fmt.Errorf("endpoint %v: %w", i, err))
+			continue
+		}
+		if b.chainID == nil {
+			b.chainID = chainID
+		} else if chainID.Cmp(b.chainID) != 0 {
+			return nil, fmt.Errorf("%w: endpoint %v chain id %v, want %v", ErrChainIDMismatch, i, chainID, b.chainID)
+		}
+		e.markVerified()
+	}
+	if b.chainID == nil {
+		return nil, fmt.Errorf("get chain id: %w", errors.Join(errs...))
+	}
+
+	if options.HealthCheckInterval > 0 {
+		b.wg.Add(1)
+		go b.healthCheckLoop()
+	}
+
+	return b, nil
+}
+
+// DialFailoverBackend connects to all endpoints and returns a FailoverBackend
+// for them.
+func DialFailoverBackend(ctx context.Context, endpoints []string, o *FailoverOptions) (*FailoverBackend, error) {
+	backends := make([]Backend, 0, len(endpoints))
+	for i, e := range endpoints {
+		b, err := ethclient.DialContext(ctx, e)
+		if err != nil {
+			return nil, fmt.Errorf("connect to endpoint %v: %w", i, err)
+		}
+		backends = append(backends, b)
+	}
+
+	return NewFailoverBackend(ctx, backends, o)
+}
+
+// Status returns health of endpoints in the order they are provided.
+func (b *FailoverBackend) Status() []EndpointStatus {
+	s := make([]EndpointStatus, 0, len(b.endpoints))
+	for _, e := range b.endpoints {
+		s = append(s, e.getStatus())
+	}
+	return s
+}
+
+// Close stops health checks.
+func (b *FailoverBackend) Close() error {
+	b.once.Do(func() {
+		close(b.quit)
+	})
+	b.wg.Wait()
+	return nil
+}
+
+func (b *FailoverBackend) healthCheckLoop() {
+	defer b.wg.Done()
+
+	ticker := time.NewTicker(b.options.HealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			b.healthCheck()
+		case <-b.quit:
+			return
+		}
+	}
+}
+
+func (b *FailoverBackend) healthCheck() {
+	ctx, cancel := context.WithTimeout(context.Background(), b.options.HealthCheckInterval)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, e := range b.endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if err := b.checkChainID(ctx, e); err != nil {
+				e.markUnhealthy(err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// checkChainID verifies that the endpoint is connected to the same chain as
+// other endpoints.
+func (b *FailoverBackend) checkChainID(ctx context.Context, e *endpoint) error {
+	chainID, err := e.backend.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+	if chainID.Cmp(b.chainID) != 0 {
+		return fmt.Errorf("%w: chain id %v, want %v", ErrChainIDMismatch, chainID, b.chainID)
+	}
+	e.markVerified()
+	return nil
+}
+
+// verify checks the chain id of the endpoint that was not available when the
+// backend was constructed.
+func (b *FailoverBackend) verify(ctx context.Context, e *endpoint) error {
+	if e.isVerified() {
+		return nil
+	}
+	if err := b.checkChainID(ctx, e); err != nil {
+		e.markUnhealthy(err)
+		return fmt.Errorf("endpoint %v: %w", e.index, err)
+	}
+	return nil
+}
+
+// candidates returns endpoints in the order in which they should be called,
+// healthy ones and those with expired cooldown first.
+func (b *FailoverBackend) candidates() []*endpoint {
+	available := make([]*endpoint, 0, len(b.endpoints))
+	var unavailable []*endpoint
+	for _, e := range b.endpoints {
+		s := e.getStatus()
+		if s.Healthy || time.Since(s.LastFailure) >= b.options.Cooldown {
+			available = append(available, e)
+		} else {
+			unavailable = append(unavailable, e)
+		}
+	}
+	return append(available, unavailable...)
+}
+
+// failover calls the function with endpoint backends until it returns a
+// result or an error that does not cause failover.
+func failover[T any](ctx context.Context, b *FailoverBackend, f func(Backend) (T, error)) (T, error) {
+	var errs []error
+	for _, e := range b.candidates() {
+		if err := b.verify(ctx, e); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		v, err := f(e.backend)
+		if err == nil {
+			e.markHealthy()
+			return v, nil
+		}
+		if ctx.Err() != nil || !b.options.IsFailover(err) {
+			return v, err
+		}
+		e.markUnhealthy(err)
+		errs = append(errs, fmt.Errorf("endpoint %v: %w", e.index, err))
+	}
+	var zero T
+	return zero, errors.Join(errs...)
+}
+
+// quorumCall calls the function with endpoint backends concurrently and
+// returns the result, or the error that is not caused by the endpoint, when
+// the quorum of endpoints agrees on it.
+func (b *FailoverBackend) quorumCall(ctx context.Context, f func(context.Context, Backend) ([]byte, error)) ([]byte, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type response struct {
+		endpoint *endpoint
+		result   []byte
+		err      error
+		// unavailable is set if the endpoint chain id could not be
+		// verified
+		unavailable bool
+	}
+
+	endpoints := b.candidates()
+	responses := make(chan response, len(endpoints))
+	for _, e := range endpoints {
+		go func() {
+			if err := b.verify(ctx, e); err != nil {
+				responses <- response{endpoint: e, err: err, unavailable: true}
+				return
+			}
+			result, err := f(ctx, e.backend)
+			responses <- response{endpoint: e, result: result, err: err}
+		}()
+	}
+
+	votes := make(map[string]int)
+	var errs []error
+	for range endpoints {
+		r := <-responses
+
+		var key string
+		switch {
+		case r.err == nil:
+			r.endpoint.markHealthy()
+			key = "result:" + hex.EncodeToString(r.result)
+		case ctx.Err() != nil:
+			return nil, r.err
+		case r.unavailable:
+			errs = append(errs, r.err)
+			continue
+		case b.options.IsFailover(r.err):
+			r.endpoint.markUnhealthy(r.err)
+			errs = append(errs, fmt.Errorf("endpoint %v: %w", r.endpoint.index, r.err))
+			continue
+		default:
+			key = "error:" + r.err.Error()
+		}
+
+		votes[key]++
+		if votes[key] >= b.options.Quorum {
+			return r.result, r.err
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("%w of %v endpoints: %w", ErrNoQuorum, b.options.Quorum, errors.Join(errs...))
+	}
+	return nil, fmt.Errorf("%w of %v endpoints", ErrNoQuorum, b.options.Quorum)
+}
+
+func (b *FailoverBackend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return failover(ctx, b, func(backend Backend) ([]byte, error) {
+		return backend.CodeAt(ctx, contract, blockNumber)
+	})
+}
+
+func (b *FailoverBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if b.options.Quorum > 1 {
+		// results of the latest block are compared only if all endpoints
+		// execute the call at the same block
+		if blockNumber == nil {
+			header, err := b.HeaderByNumber(ctx, nil)
+			if err != nil {
+				return nil, fmt.Errorf("get latest block: %w", err)
+			}
+			blockNumber = header.Number
+		}
+		return b.quorumCall(ctx, func(ctx context.Context, backend Backend) ([]byte, error) {
+			return backend.CallContract(ctx, call, blockNumber)
+		})
+	}
+	return failover(ctx, b, func(backend Backend) ([]byte, error) {
+		return backend.CallContract(ctx, call, blockNumber)
+	})
+}
+
+func (b *FailoverBackend) PendingCallContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error) {
+	f := func(ctx context.Context, backend Backend) ([]byte, error) {
+		if pending, ok := backend.(ethereum.PendingContractCaller); ok {
+			return pending.PendingCallContract(ctx, call)
+		}
+		return backend.CallContract(ctx, call, nil)
+	}
+	if b.options.Quorum > 1 {
+		return b.quorumCall(ctx, f)
+	}
+	return failover(ctx, b, func(backend Backend) ([]byte, error) {
+		return f(ctx, backend)
+	})
+}
+
+func (b *FailoverBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return failover(ctx, b, func(backend Backend) (*types.Header, error) {
+		return backend.HeaderByNumber(ctx, number)
+	})
+}
+
+func (b *FailoverBackend) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return failover(ctx, b, func(backend Backend) ([]byte, error) {
+		return backend.PendingCodeAt(ctx, account)
+	})
+}
+
+func (b *FailoverBackend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return failover(ctx, b, func(backend Backend) (uint64, error) {
+		return backend.PendingNonceAt(ctx, account)
+	})
+}
+
+func (b *FailoverBackend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return failover(ctx, b, func(backend Backend) (*big.Int, error) {
+		return backend.SuggestGasPrice(ctx)
+	})
+}
+
+func (b *FailoverBackend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return failover(ctx, b, func(backend Backend) (*big.Int, error) {
+		return backend.SuggestGasTipCap(ctx)
+	})
+}
+
+func (b *FailoverBackend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return failover(ctx, b, func(backend Backend) (uint64, error) {
+		return backend.EstimateGas(ctx, call)
+	})
+}
+
+// SendTransaction broadcasts the signed transaction to the next endpoint if
+// the previous one fails. A transaction that is already known to the next
+// endpoint is considered sent.
+func (b *FailoverBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	failed := false
+	_, err := failover(ctx, b, func(backend Backend) (struct{}, error) {
+		err := backend.SendTransaction(ctx, tx)
+		if err != nil && failed && isKnownTransactionError(err) {
+			return struct{}{}, nil
+		}
+		failed = err != nil
+		return struct{}{}, err
+	})
+	return err
+}
+
+func (b *FailoverBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return failover(ctx, b, func(backend Backend) ([]types.Log, error) {
+		return backend.FilterLogs(ctx, query)
+	})
+}
+
+func (b *FailoverBackend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return failover(ctx, b, func(backend Backend) (ethereum.Subscription, error) {
+		return backend.SubscribeFilterLogs(ctx, query, ch)
+	})
+}
+
+func (b *FailoverBackend) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return failover(ctx, b, func(backend Backend) (*types.Receipt, error) {
+		return backend.TransactionReceipt(ctx, txHash)
+	})
+}
+
+// ChainID returns the chain id that all endpoints are connected to.
+func (b *FailoverBackend) ChainID(ctx context.Context) (*big.Int, error) {
+	return new(big.Int).Set(b.chainID), nil
+}
@@ -0,0 +1,246 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas_test
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+
+	"resenje.org/eas"
+	"resenje.org/eas/eastest"
+)
+
+func TestFailoverBackend(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, nil)
+
+	newFailover := func(t *testing.T, o *eas.FailoverOptions, backends ...eas.Backend) (*eas.FailoverBackend, *eas.Client) {
+		t.Helper()
+
+		b, err := eas.NewFailoverBackend(ctx, backends, o)
+		assertNilError(t, err)
+		t.Cleanup(func() { _ = b.Close() })
+
+		c, err := eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EAS, &eas.Options{
+			Backend:                       b,
+			SchemaRegistryContractAddress: h.Addresses.SchemaRegistry,
+		})
+		assertNilError(t, err)
+		return b, c
+	}
+
+	t.Run("failover", func(t *testing.T) {
+		unavailable := eastest.NewFaultBackend(h.Simulated, nil)
+		unavailable.Inject(eastest.Fault{
+			Err: eastest.HTTPError(http.StatusServiceUnavailable, nil),
+		})
+		primary := eastest.NewFaultBackend(h.Simulated, nil)
+		secondary := eastest.NewFaultBackend(h.Simulated, nil)
+
+		b, c := newFailover(t, nil, unavailable, primary, secondary)
+
+		_, wait, err := c.SchemaRegistry.Register(ctx, "string failover", [20]byte{}, true)
+		assertNilError(t, err)
+
+		h.Commit()
+
+		_, err = wait(ctx)
+		assertNilError(t, err)
+
+		status := b.Status()
+		assertEqual(t, "unavailable healthy", status[0].Healthy, false)
+		assertEqual(t, "primary healthy", status[1].Healthy, true)
+		assertEqual(t, "secondary healthy", status[2].Healthy, true)
+		assertEqual(t, "primary sent", primary.Calls("SendTransaction"), 1)
+		assertEqual(t, "secondary calls", secondary.Calls("CallContract"), 0)
+
+		// endpoint in cooldown is called after the healthy ones
+		calls := unavailable.Calls("CallContract")
+		_, err = c.SchemaRegistry.Version(ctx)
+		assertNilError(t, err)
+		assertEqual(t, "unavailable calls", unavailable.Calls("CallContract"), calls)
+	})
+
+	t.Run("revert", func(t *testing.T) {
+		primary := eastest.NewFaultBackend(h.Simulated, nil)
+		secondary := eastest.NewFaultBackend(h.Simulated, nil)
+
+		b, c := newFailover(t, nil, primary, secondary)
+
+		primary.Inject(eastest.Fault{
+			Method: "CallContract",
+			Times:  1,
+			Err:    eastest.RevertError(nil),
+		})
+
+		_, err := c.SchemaRegistry.Version(ctx)
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		assertEqual(t, "secondary calls", secondary.Calls("CallContract"), 0)
+		assertEqual(t, "primary healthy", b.Status()[0].Healthy, true)
+	})
+
+	t.Run("all unavailable", func(t *testing.T) {
+		primary := eastest.NewFaultBackend(h.Simulated, nil)
+		secondary := eastest.NewFaultBackend(h.Simulated, nil)
+
+		_, c := newFailover(t, nil, primary, secondary)
+
+		for _, f := range []*eastest.FaultBackend{primary, secondary} {
+			f.Inject(eastest.Fault{
+				Method: "CallContract",
+				Err:    eastest.HTTPError(http.StatusBadGateway, nil),
+			})
+		}
+
+		primaryCalls := primary.Calls("CallContract")
+		secondaryCalls := secondary.Calls("CallContract")
+
+		_, err := c.SchemaRegistry.Version(ctx)
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		assertEqual(t, "primary calls", primary.Calls("CallContract"), primaryCalls+1)
+		assertEqual(t, "secondary calls", secondary.Calls("CallContract"), secondaryCalls+1)
+	})
+
+	t.Run("unavailable on construction", func(t *testing.T) {
+		unavailable := eastest.NewFaultBackend(h.Simulated, nil)
+		unavailable.Inject(eastest.Fault{
+			Method: "ChainID",
+			Times:  1,
+			Err:    eastest.HTTPError(http.StatusServiceUnavailable, nil),
+		})
+		secondary := eastest.NewFaultBackend(h.Simulated, nil)
+
+		b, c := newFailover(t, &eas.FailoverOptions{Cooldown: time.Nanosecond}, unavailable, secondary)
+
+		_, err := c.SchemaRegistry.Version(ctx)
+		assertNilError(t, err)
+		assertEqual(t, "chain id calls", unavailable.Calls("ChainID"), 2)
+		assertEqual(t, "healthy", b.Status()[0].Healthy, true)
+	})
+
+	t.Run("chain id mismatch", func(t *testing.T) {
+		_, err := eas.NewFailoverBackend(ctx, []eas.Backend{
+			h.Simulated.Client(),
+			&chainIDBackend{Backend: h.Simulated.Client(), chainID: big.NewInt(1)},
+		}, nil)
+		assertErrorIs(t, err, eas.ErrChainIDMismatch)
+	})
+
+	t.Run("quorum", func(t *testing.T) {
+		corrupt := &corruptBackend{Backend: h.Simulated.Client()}
+
+		_, c := newFailover(t, &eas.FailoverOptions{Quorum: 2}, corrupt, h.Simulated.Client(), h.Simulated.Client())
+
+		version, err := c.SchemaRegistry.Version(ctx)
+		assertNilError(t, err)
+		assertEqual(t, "version", version, "1.0.0")
+
+		// calls of the latest block are pinned to the same block number
+		pinned := []*blockNumberBackend{
+			{Backend: h.Simulated.Client()},
+			{Backend: h.Simulated.Client()},
+		}
+		_, c = newFailover(t, &eas.FailoverOptions{Quorum: 2}, pinned[0], pinned[1])
+
+		h.Commit()
+		latest, err := h.Simulated.Client().BlockNumber(ctx)
+		assertNilError(t, err)
+
+		_, err = c.SchemaRegistry.Version(ctx)
+		assertNilError(t, err)
+		for i, p := range pinned {
+			if p.blockNumber == nil {
+				t.Fatalf("backend %v: call without block number", i)
+			}
+			assertEqual(t, "block number", p.blockNumber.Uint64(), latest)
+		}
+
+		// version detection on construction requires the quorum
+		b, err := eas.NewFailoverBackend(ctx, []eas.Backend{corrupt, h.Simulated.Client(), h.Simulated.Client()}, &eas.FailoverOptions{Quorum: 3})
+		assertNilError(t, err)
+
+		_, err = eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EAS, &eas.Options{
+			Backend: b,
+		})
+		assertErrorIs(t, err, eas.ErrNoQuorum)
+	})
+
+	t.Run("health check", func(t *testing.T) {
+		primary := eastest.NewFaultBackend(h.Simulated, nil)
+		secondary := eastest.NewFaultBackend(h.Simulated, nil)
+
+		b, c := newFailover(t, &eas.FailoverOptions{
+			Cooldown:            time.Hour,
+			HealthCheckInterval: 10 * time.Millisecond,
+		}, primary, secondary)
+
+		primary.Inject(eastest.Fault{
+			Method: "CallContract",
+			Times:  1,
+			Err:    eastest.HTTPError(http.StatusServiceUnavailable, nil),
+		})
+
+		_, err := c.SchemaRegistry.Version(ctx)
+		assertNilError(t, err)
+		assertEqual(t, "secondary calls", secondary.Calls("CallContract"), 1)
+
+		deadline := time.Now().Add(5 * time.Second)
+		for !b.Status()[0].Healthy {
+			if time.Now().After(deadline) {
+				t.Fatal("primary endpoint not healthy")
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		_, err = c.SchemaRegistry.Version(ctx)
+		assertNilError(t, err)
+		assertEqual(t, "secondary calls", secondary.Calls("CallContract"), 1)
+	})
+}
+
+type chainIDBackend struct {
+	eas.Backend
+	chainID *big.Int
+}
+
+func (b *chainIDBackend) ChainID(context.Context) (*big.Int, error) {
+	return b.chainID, nil
+}
+
+// blockNumberBackend records the block number of the last contract call.
+type blockNumberBackend struct {
+	eas.Backend
+	blockNumber *big.Int
+}
+
+func (b *blockNumberBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	b.blockNumber = blockNumber
+	return b.Backend.CallContract(ctx, call, blockNumber)
+}
+
+// corruptBackend returns altered results of contract calls.
+type corruptBackend struct {
+	eas.Backend
+}
+
+func (b *corruptBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	result, err := b.Backend.CallContract(ctx, call, blockNumber)
+	if err != nil || len(result) == 0 {
+		return result, err
+	}
+	result[len(result)-1]++
+	return result, nil
+}
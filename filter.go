@@ -0,0 +1,282 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// defaultFilterChunkSize is the number of blocks in a single logs query if
+// Options.FilterChunkSize is not set.
+const defaultFilterChunkSize = 10000
+
+// filterIterator queries logs of a block range in chunks, lazily as values
+// are iterated. Chunks are halved when the endpoint rejects a query because
+// of too many results or a too wide block range, and are grown back after
+// successful queries, up to the maximal chunk size. Multiple chunks can be
+// queried concurrently, while values are returned in the order of logs.
+type filterIterator[T any] struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	backend Backend
+	query   ethereum.FilterQuery
+	parse   func(types.Log) (T, error)
+
+	next         uint64 // first block of the next chunk
+	end          uint64
+	done         bool // all chunks are scheduled
+	chunkSize    atomic.Uint64
+	maxChunkSize uint64
+	concurrency  int
+	pending      []chan filterChunk
+
+	logs  []types.Log
+	value T
+	err   error
+}
+
+type filterChunk struct {
+	logs []types.Log
+	err  error
+}
+
+// eventQuery returns the logs query of the contract event. Rules are values of
+// indexed event arguments, in the order of arguments.
+func eventQuery(address common.Address, event abi.Event, rules [][]any) (ethereum.FilterQuery, error) {
+	topics, err := abi.MakeTopics(append([][]any{{event.ID}}, rules...)...)
+	if err != nil {
+		return ethereum.FilterQuery{}, fmt.Errorf("make topics: %w", err)
+	}
+	return ethereum.FilterQuery{
+		Addresses: []common.Address{address},
+		Topics:    topics,
+	}, nil
+}
+
+// newFilterIterator returns an iterator over logs of the contract event from
+// the start to the end block. If the end is nil, the latest block is used.
+// Rules are values of indexed event arguments, in the order of arguments.
+func newFilterIterator[T any](ctx context.Context, client *Client, address common.Address, event abi.Event, start uint64, end *uint64, rules [][]any, parse func(types.Log) (T, error)) (Iterator[T], error) {
+	query, err := eventQuery(address, event, rules)
+	if err != nil {
+		return nil, err
+	}
+	return newQueryIterator(ctx, client, query, start, end, parse)
+}
+
+// newQueryIterator returns an iterator over logs of the query, without the
+// block range, from the start to the end block. If the end is nil, the latest
+// block is used.
+func newQueryIterator[T any](ctx context.Context, client *Client, query ethereum.FilterQuery, start uint64, end *uint64, parse func(types.Log) (T, error)) (Iterator[T], error) {
+	var last uint64
+	if end != nil {
+		last = *end
+	} else {
+		header, err := client.backend.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, fmt.Errorf("get latest block: %w", err)
+		}
+		last = header.Number.Uint64()
+	}
+
+	chunkSize := client.options.FilterChunkSize
+	if chunkSize == 0 {
+		chunkSize = defaultFilterChunkSize
+	}
+	concurrency := max(1, client.options.FilterConcurrency)
+
+	ctx, cancel := context.WithCancel(ctx)
+	it := &filterIterator[T]{
+		ctx:          ctx,
+		cancel:       cancel,
+		backend:      client.backend,
+		query:        query,
+		parse:        parse,
+		next:         start,
+		end:          last,
+		done:         start > last,
+		maxChunkSize: chunkSize,
+		concurrency:  concurrency,
+	}
+	it.chunkSize.Store(chunkSize)
+	return it, nil
+}
+
+func (it *filterIterator[T]) Next() bool {
+	for {
+		if it.err != nil {
+			return false
+		}
+
+		if len(it.logs) > 0 {
+			l := it.logs[0]
+			it.logs = it.logs[1:]
+			v, err := it.parse(l)
+			if err != nil {
+				it.err = fmt.Errorf("parse log: %w", err)
+				it.cancel()
+				return false
+			}
+			it.value = v
+			return true
+		}
+
+		it.schedule()
+
+		if len(it.pending) == 0 {
+			return false
+		}
+
+		c := <-it.pending[0]
+		it.pending = it.pending[1:]
+		if c.err != nil {
+			it.err = c.err
+			it.cancel()
+			return false
+		}
+		it.logs = c.logs
+	}
+}
+
+func (it *filterIterator[T]) Value() T {
+	return it.value
+}
+
+func (it *filterIterator[T]) Error() error {
+	return it.err
+}
+
+func (it *filterIterator[T]) Close() error {
+	it.cancel()
+	return nil
+}
+
+// schedule starts queries of next chunks, up to the concurrency limit.
+func (it *filterIterator[T]) schedule() {
+	for !it.done && len(it.pending) < it.concurrency {
+		from := it.next
+		to := it.end
+		if size := it.chunkSize.Load(); to-from >= size {
+			to = from + size - 1
+		}
+		if to == it.end {
+			it.done = true
+		} else {
+			it.next = to + 1
+		}
+
+		c := make(chan filterChunk, 1)
+		go func() {
+			logs, err := it.filterLogs(from, to)
+			c <- filterChunk{logs: logs, err: err}
+		}()
+		it.pending = append(it.pending, c)
+	}
+}
+
+// filterLogs queries logs of the block range and splits the range in halves
+// if the query is rejected because of its size.
+func (it *filterIterator[T]) filterLogs(from, to uint64) ([]types.Log, error) {
+	q := it.query
+	q.FromBlock = new(big.Int).SetUint64(from)
+	q.ToBlock = new(big.Int).SetUint64(to)
+
+	logs, err := it.backend.FilterLogs(it.ctx, q)
+	if err == nil {
+		it.growChunkSize(to - from + 1)
+		return logs, nil
+	}
+	if from == to || it.ctx.Err() != nil || !isFilterLimitError(err) {
+		return nil, fmt.Errorf("filter logs from block %v to %v: %w", from, to, err)
+	}
+
+	mid := from + (to-from)/2
+	it.shrinkChunkSize(mid - from + 1)
+
+	left, err := it.filterLogs(from, mid)
+	if err != nil {
+		return nil, err
+	}
+	right, err := it.filterLogs(mid+1, to)
+	if err != nil {
+		return nil, err
+	}
+	return append(left, right...), nil
+}
+
+func (it *filterIterator[T]) shrinkChunkSize(size uint64) {
+	for {
+		current := it.chunkSize.Load()
+		if size >= current || it.chunkSize.CompareAndSwap(current, size) {
+			return
+		}
+	}
+}
+
+// growChunkSize doubles the chunk size after a successful query of the
+// current chunk size.
+func (it *filterIterator[T]) growChunkSize(size uint64) {
+	current := it.chunkSize.Load()
+	if size < current || current >= it.maxChunkSize {
+		return
+	}
+	it.chunkSize.CompareAndSwap(current, min(2*current, it.maxChunkSize))
+}
+
+// filterLimitErrorMessages are parts of error messages that RPC providers
+// return when a logs query has too many results or a too wide block range.
+var filterLimitErrorMessages = []string{
+	"query returned more than",
+	"too many results",
+	"too many logs",
+	"log response size exceeded",
+	"response size exceeded",
+	"response is too big",
+	"block range is too wide",
+	"exceed maximum block range",
+	"exceeds maximum block range",
+	"block range limit exceeded",
+	"range is too large",
+	"range too large",
+	"query timeout exceeded",
+}
+
+// isFilterLimitError reports if the logs query should be retried with a
+// smaller block range.
+func isFilterLimitError(err error) bool {
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode == http.StatusRequestEntityTooLarge
+	}
+	msg := strings.ToLower(err.Error())
+	for _, m := range filterLimitErrorMessages {
+		if strings.Contains(msg, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterRule converts values of an indexed event argument to a topic rule.
+func filterRule[T any](s []T) []any {
+	r := make([]any, 0, len(s))
+	for _, v := range s {
+		r = append(r, v)
+	}
+	return r
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"resenje.org/eas"
+	"resenje.org/eas/eastest"
+)
+
+func TestEASContract_FilterAttested_chunks(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, &eastest.Options{Faults: true})
+
+	_, wait, err := h.Client().SchemaRegistry.Register(ctx, "string message", [20]byte{}, true)
+	assertNilError(t, err)
+	h.Commit()
+	r, err := wait(ctx)
+	assertNilError(t, err)
+
+	var uids []eas.UID
+	for i := 0; i < 10; i++ {
+		_, wait, err := h.Client().EAS.Attest(ctx, r.UID, nil, fmt.Sprintf("Hello %v!", i))
+		assertNilError(t, err)
+		h.Commit()
+		a, err := wait(ctx)
+		assertNilError(t, err)
+		uids = append(uids, a.UID)
+	}
+
+	header, err := h.Simulated.Client().HeaderByNumber(ctx, nil)
+	assertNilError(t, err)
+	blocks := int(header.Number.Uint64()) + 1
+
+	filter := func(t *testing.T, o *eas.Options) ([]eas.UID, error) {
+		t.Helper()
+
+		o.Backend = h.Backend()
+		c, err := eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EAS, o)
+		assertNilError(t, err)
+
+		it, err := c.EAS.FilterAttested(ctx, 0, nil, nil, nil, nil)
+		assertNilError(t, err)
+		defer it.Close()
+
+		var got []eas.UID
+		for it.Next() {
+			got = append(got, it.Value().UID)
+		}
+		return got, it.Error()
+	}
+
+	t.Run("chunks", func(t *testing.T) {
+		h.Faults.Reset()
+
+		got, err := filter(t, &eas.Options{FilterChunkSize: 3})
+		assertNilError(t, err)
+		assertEqual(t, "uids", got, uids)
+		assertEqual(t, "calls", h.Faults.Calls("FilterLogs"), (blocks+2)/3)
+	})
+
+	t.Run("concurrency", func(t *testing.T) {
+		h.Faults.Reset()
+
+		got, err := filter(t, &eas.Options{FilterChunkSize: 2, FilterConcurrency: 4})
+		assertNilError(t, err)
+		assertEqual(t, "uids", got, uids)
+		assertEqual(t, "calls", h.Faults.Calls("FilterLogs"), (blocks+1)/2)
+	})
+
+	t.Run("too many results", func(t *testing.T) {
+		h.Faults.Reset()
+
+		got, err := filter(t, &eas.Options{FilterChunkSize: 100})
+		assertNilError(t, err)
+		assertEqual(t, "uids", got, uids)
+		assertEqual(t, "calls", h.Faults.Calls("FilterLogs"), 1)
+
+		h.Faults.Reset()
+		h.Faults.Inject(eastest.Fault{
+			Method: "FilterLogs",
+			Times:  1,
+			Err:    errors.New("query returned more than 10000 results"),
+		})
+
+		got, err = filter(t, &eas.Options{FilterChunkSize: 100})
+		assertNilError(t, err)
+		assertEqual(t, "uids", got, uids)
+		if calls := h.Faults.Calls("FilterLogs"); calls < 3 {
+			t.Errorf("got calls %v, want at least 3", calls)
+		}
+	})
+
+	t.Run("provider limit errors", func(t *testing.T) {
+		for _, msg := range []string{
+			"block range is too wide",
+			"exceed maximum block range: 5000",
+			"Log response size exceeded.",
+		} {
+			h.Faults.Reset()
+			h.Faults.Inject(eastest.Fault{
+				Method: "FilterLogs",
+				Times:  1,
+				Err:    errors.New(msg),
+			})
+
+			got, err := filter(t, &eas.Options{FilterChunkSize: 100})
+			assertNilError(t, err)
+			assertEqual(t, "uids", got, uids)
+			if calls := h.Faults.Calls("FilterLogs"); calls < 3 {
+				t.Errorf("%s: got calls %v, want at least 3", msg, calls)
+			}
+		}
+	})
+
+	t.Run("invalid block range", func(t *testing.T) {
+		h.Faults.Reset()
+		h.Faults.Inject(eastest.Fault{
+			Method: "FilterLogs",
+			Times:  1,
+			Err:    errors.New("invalid block range params"),
+		})
+
+		_, err := filter(t, &eas.Options{FilterChunkSize: 100})
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		assertEqual(t, "calls", h.Faults.Calls("FilterLogs"), 1)
+	})
+
+	t.Run("single block rejected", func(t *testing.T) {
+		h.Faults.Reset()
+		h.Faults.Inject(eastest.Fault{
+			Method: "FilterLogs",
+			Err:    errors.New("query returned more than 10000 results"),
+		})
+
+		_, err := filter(t, &eas.Options{FilterChunkSize: 1})
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		assertEqual(t, "calls", h.Faults.Calls("FilterLogs"), 1)
+	})
+
+	t.Run("other error", func(t *testing.T) {
+		h.Faults.Reset()
+		h.Faults.Inject(eastest.Fault{
+			Method: "FilterLogs",
+			Times:  1,
+			Err:    eastest.HTTPError(http.StatusBadRequest, nil),
+		})
+
+		_, err := filter(t, &eas.Options{FilterChunkSize: 100})
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		assertEqual(t, "calls", h.Faults.Calls("FilterLogs"), 1)
+	})
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphql
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"resenje.org/eas"
+)
+
+// AttestationsQuery selects attestations. Attestations match if they match
+// all set fields, and any of the values of a field.
+type AttestationsQuery struct {
+	Recipients []common.Address
+	Attesters  []common.Address
+	Schemas    []eas.UID
+	RefUIDs    []eas.UID
+	// Revoked, if set, selects revoked or not revoked attestations.
+	Revoked *bool
+	// Offchain, if set, selects offchain or onchain attestations.
+	Offchain *bool
+	// From and To limit the attestation time. From is inclusive and To is
+	// exclusive.
+	From time.Time
+	To   time.Time
+	// Valid selects only attestations that are not revoked and not expired
+	// at the ValidAt time, or the current time if it is not set.
+	Valid   bool
+	ValidAt time.Time
+	// OrderBy orders attestations by fields of the API, such as "time" or
+	// "expirationTime".
+	OrderBy []Order
+	// Skip is the number of skipped attestations and Take is the maximal
+	// number of returned attestations, if not zero.
+	Skip int
+	Take int
+}
+
+func (q *AttestationsQuery) where() map[string]any {
+	w := make(map[string]any)
+	var and []any
+	if len(q.Recipients) > 0 {
+		w["recipient"] = inFilter(q.Recipients)
+	}
+	if len(q.Attesters) > 0 {
+		w["attester"] = inFilter(q.Attesters)
+	}
+	if len(q.Schemas) > 0 {
+		w["schemaId"] = inFilter(q.Schemas)
+	}
+	if len(q.RefUIDs) > 0 {
+		w["refUID"] = inFilter(q.RefUIDs)
+	}
+	if q.Revoked != nil {
+		w["revoked"] = map[string]any{"equals": *q.Revoked}
+	}
+	if q.Offchain != nil {
+		w["isOffchain"] = map[string]any{"equals": *q.Offchain}
+	}
+	if t := timeFilter(q.From, q.To); t != nil {
+		w["time"] = t
+	}
+	if q.Valid {
+		at := q.ValidAt
+		if at.IsZero() {
+			at = time.Now()
+		}
+		and = append(and,
+			map[string]any{"revoked": map[string]any{"equals": false}},
+			map[string]any{"OR": []any{
+				map[string]any{"expirationTime": map[string]any{"equals": 0}},
+				map[string]any{"expirationTime": map[string]any{"gt": at.Unix()}},
+			}},
+		)
+	}
+	if len(and) > 0 {
+		w["AND"] = and
+	}
+	return w
+}
+
+const attestationFields = `id schemaId attester recipient refUID revocable revocationTime expirationTime time data`
+
+type attestationResult struct {
+	ID             eas.UID        `json:"id"`
+	SchemaID       eas.UID        `json:"schemaId"`
+	Attester       common.Address `json:"attester"`
+	Recipient      common.Address `json:"recipient"`
+	RefUID         eas.UID        `json:"refUID"`
+	Revocable      bool           `json:"revocable"`
+	RevocationTime int64          `json:"revocationTime"`
+	ExpirationTime int64          `json:"expirationTime"`
+	Time           int64          `json:"time"`
+	Data           hexutil.Bytes  `json:"data"`
+}
+
+func (r *attestationResult) attestation() *eas.Attestation {
+	return &eas.Attestation{
+		UID:            r.ID,
+		Schema:         r.SchemaID,
+		Time:           time.Unix(r.Time, 0),
+		ExpirationTime: time.Unix(r.ExpirationTime, 0),
+		RevocationTime: time.Unix(r.RevocationTime, 0),
+		RefUID:         r.RefUID,
+		Recipient:      r.Recipient,
+		Attester:       r.Attester,
+		Revocable:      r.Revocable,
+		Data:           r.Data,
+	}
+}
+
+// Attestation returns the attestation with the uid.
+func (c *Client) Attestation(ctx context.Context, uid eas.UID) (*eas.Attestation, error) {
+	var r struct {
+		Attestation *attestationResult `json:"attestation"`
+	}
+	if err := c.Do(ctx, `query Attestation($where: AttestationWhereUniqueInput!) {
+  attestation(where: $where) { `+attestationFields+` }
+}`, map[string]any{
+		"where": map[string]any{"id": uid},
+	}, &r); err != nil {
+		return nil, err
+	}
+	if r.Attestation == nil {
+		return nil, ErrNotFound
+	}
+	return r.Attestation.attestation(), nil
+}
+
+// Attestations returns attestations that match the query.
+func (c *Client) Attestations(ctx context.Context, q *AttestationsQuery) ([]*eas.Attestation, error) {
+	if q == nil {
+		q = new(AttestationsQuery)
+	}
+	var r struct {
+		Attestations []attestationResult `json:"attestations"`
+	}
+	if err := c.Do(ctx, `query Attestations($where: AttestationWhereInput, $orderBy: [AttestationOrderByWithRelationInput!], $skip: Int, $take: Int) {
+  attestations(where: $where, orderBy: $orderBy, skip: $skip, take: $take) { `+attestationFields+` }
+}`, listVariables(q.where(), q.OrderBy, q.Skip, q.Take), &r); err != nil {
+		return nil, err
+	}
+	s := make([]*eas.Attestation, 0, len(r.Attestations))
+	for _, a := range r.Attestations {
+		s = append(s, a.attestation())
+	}
+	return s, nil
+}
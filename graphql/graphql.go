@@ -0,0 +1,201 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package graphql provides a client for the EAS GraphQL indexer API, such as
+// the one hosted by easscan.org, that returns attestations and schemas as
+// types of the eas package.
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ErrNotFound is returned if the requested record does not exist.
+var ErrNotFound = errors.New("not found")
+
+// Error is returned if the API responds with GraphQL errors.
+type Error struct {
+	Messages []string
+}
+
+func (e *Error) Error() string {
+	return "graphql: " + strings.Join(e.Messages, "; ")
+}
+
+// HTTPError is returned if the API responds with an unexpected HTTP status.
+type HTTPError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("graphql: http status %v %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Body)
+}
+
+// Options configure the Client.
+type Options struct {
+	// HTTPClient is used for requests. If not set, http.DefaultClient is
+	// used.
+	HTTPClient *http.Client
+	// Header is added to every request, for example with API keys.
+	Header http.Header
+}
+
+// Client queries the EAS GraphQL API.
+type Client struct {
+	endpoint   string
+	httpClient *http.Client
+	header     http.Header
+}
+
+// NewClient returns a new Client of the API at the endpoint URL. Endpoints of
+// hosted APIs are provided by the deployments package.
+func NewClient(endpoint string, o *Options) *Client {
+	if o == nil {
+		o = new(Options)
+	}
+	httpClient := o.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{
+		endpoint:   endpoint,
+		httpClient: httpClient,
+		header:     o.Header,
+	}
+}
+
+// maxErrorBodySize limits the response body in HTTPError.
+const maxErrorBodySize = 1024
+
+// Do sends the GraphQL query with variables and decodes the data of the
+// response into the result.
+func (c *Client) Do(ctx context.Context, query string, variables map[string]any, result any) error {
+	body, err := json.Marshal(struct {
+		Query     string         `json:"query"`
+		Variables map[string]any `json:"variables,omitempty"`
+	}{
+		Query:     query,
+		Variables: variables,
+	})
+	if err != nil {
+		return fmt.Errorf("encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	for k, v := range c.header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return &HTTPError{
+			StatusCode: resp.StatusCode,
+			Body:       string(data),
+		}
+	}
+
+	var r struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	if len(r.Errors) > 0 {
+		messages := make([]string, 0, len(r.Errors))
+		for _, e := range r.Errors {
+			messages = append(messages, e.Message)
+		}
+		return &Error{Messages: messages}
+	}
+	if result == nil {
+		return nil
+	}
+	if err := json.Unmarshal(r.Data, result); err != nil {
+		return fmt.Errorf("decode response data: %w", err)
+	}
+	return nil
+}
+
+// Order orders records by the field of the API.
+type Order struct {
+	Field      string
+	Descending bool
+}
+
+// listVariables returns variables of queries of record lists.
+func listVariables(where map[string]any, orderBy []Order, skip, take int) map[string]any {
+	v := make(map[string]any)
+	if len(where) > 0 {
+		v["where"] = where
+	}
+	if len(orderBy) > 0 {
+		o := make([]map[string]string, 0, len(orderBy))
+		for _, e := range orderBy {
+			direction := "asc"
+			if e.Descending {
+				direction = "desc"
+			}
+			o = append(o, map[string]string{e.Field: direction})
+		}
+		v["orderBy"] = o
+	}
+	if skip > 0 {
+		v["skip"] = skip
+	}
+	if take > 0 {
+		v["take"] = take
+	}
+	return v
+}
+
+// inFilter returns the filter of string fields that match any of the values.
+// Addresses are encoded with checksums and uids as lowercase hex, as they
+// are stored by the indexer.
+func inFilter[T fmt.Stringer](values []T) map[string]any {
+	s := make([]string, 0, len(values))
+	for _, v := range values {
+		s = append(s, v.String())
+	}
+	return map[string]any{"in": s}
+}
+
+// timeFilter returns the filter of unix time fields, or nil if the range is
+// not limited.
+func timeFilter(from, to time.Time) map[string]any {
+	if from.IsZero() && to.IsZero() {
+		return nil
+	}
+	f := make(map[string]any)
+	if !from.IsZero() {
+		f["gte"] = from.Unix()
+	}
+	if !to.IsZero() {
+		f["lt"] = to.Unix()
+	}
+	return f
+}
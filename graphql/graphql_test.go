@@ -0,0 +1,256 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphql_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"resenje.org/eas"
+	"resenje.org/eas/graphql"
+)
+
+type request struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables"`
+}
+
+// newServer returns a client of a test server that records requests and
+// responds with the response.
+func newServer(t *testing.T, status int, response string) (*graphql.Client, *request) {
+	t.Helper()
+
+	var got request
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("got method %s, want POST", r.Method)
+		}
+		if v := r.Header.Get("X-Api-Key"); v != "secret" {
+			t.Errorf("got api key header %q", v)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Error(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(s.Close)
+
+	return graphql.NewClient(s.URL, &graphql.Options{
+		Header: http.Header{"X-Api-Key": []string{"secret"}},
+	}), &got
+}
+
+const (
+	testUID       = "0x2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a"
+	testSchemaUID = "0x1111111111111111111111111111111111111111111111111111111111111111"
+	testRecipient = "0x08752c431c3e38B12e94A0F195B166590e764831"
+)
+
+var testAttestation = `{
+	"id": "` + testUID + `",
+	"schemaId": "` + testSchemaUID + `",
+	"attester": "0x0000000000000000000000000000000000000001",
+	"recipient": "` + testRecipient + `",
+	"refUID": "0x0000000000000000000000000000000000000000000000000000000000000000",
+	"revocable": true,
+	"revocationTime": 0,
+	"expirationTime": 1720000000,
+	"time": 1710000000,
+	"data": "0x0102"
+}`
+
+func TestClient_Attestations(t *testing.T) {
+	ctx := context.Background()
+
+	c, got := newServer(t, http.StatusOK, `{"data": {"attestations": [`+testAttestation+`]}}`)
+
+	validAt := time.Unix(1715000000, 0)
+	attestations, err := c.Attestations(ctx, &graphql.AttestationsQuery{
+		Recipients: []common.Address{common.HexToAddress(testRecipient)},
+		Schemas:    []eas.UID{eas.HexDecodeUID(testSchemaUID)},
+		From:       time.Unix(1700000000, 0),
+		Valid:      true,
+		ValidAt:    validAt,
+		OrderBy:    []graphql.Order{{Field: "time", Descending: true}},
+		Skip:       10,
+		Take:       5,
+	})
+	assertNilError(t, err)
+
+	if !strings.Contains(got.Query, "attestations(where: $where, orderBy: $orderBy, skip: $skip, take: $take)") {
+		t.Errorf("unexpected query %s", got.Query)
+	}
+	assertJSONEqual(t, "variables", got.Variables, `{
+		"where": {
+			"recipient": {"in": ["`+testRecipient+`"]},
+			"schemaId": {"in": ["`+testSchemaUID+`"]},
+			"time": {"gte": 1700000000},
+			"AND": [
+				{"revoked": {"equals": false}},
+				{"OR": [
+					{"expirationTime": {"equals": 0}},
+					{"expirationTime": {"gt": 1715000000}}
+				]}
+			]
+		},
+		"orderBy": [{"time": "desc"}],
+		"skip": 10,
+		"take": 5
+	}`)
+
+	assertEqual(t, "attestations", attestations, []*eas.Attestation{{
+		UID:            eas.HexDecodeUID(testUID),
+		Schema:         eas.HexDecodeUID(testSchemaUID),
+		Time:           time.Unix(1710000000, 0),
+		ExpirationTime: time.Unix(1720000000, 0),
+		RevocationTime: time.Unix(0, 0),
+		Recipient:      common.HexToAddress(testRecipient),
+		Attester:       common.HexToAddress("0x0000000000000000000000000000000000000001"),
+		Revocable:      true,
+		Data:           []byte{1, 2},
+	}})
+	assertEqual(t, "revoked", attestations[0].IsRevoked(), false)
+}
+
+func TestClient_Attestation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("found", func(t *testing.T) {
+		c, got := newServer(t, http.StatusOK, `{"data": {"attestation": `+testAttestation+`}}`)
+
+		a, err := c.Attestation(ctx, eas.HexDecodeUID(testUID))
+		assertNilError(t, err)
+		assertEqual(t, "uid", a.UID, eas.HexDecodeUID(testUID))
+		assertJSONEqual(t, "variables", got.Variables, `{"where": {"id": "`+testUID+`"}}`)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		c, _ := newServer(t, http.StatusOK, `{"data": {"attestation": null}}`)
+
+		_, err := c.Attestation(ctx, eas.HexDecodeUID(testUID))
+		assertErrorIs(t, err, graphql.ErrNotFound)
+	})
+
+	t.Run("graphql error", func(t *testing.T) {
+		c, _ := newServer(t, http.StatusOK, `{"data": null, "errors": [{"message": "invalid argument"}]}`)
+
+		_, err := c.Attestation(ctx, eas.HexDecodeUID(testUID))
+		var gErr *graphql.Error
+		if !errors.As(err, &gErr) {
+			t.Fatalf("got error %v, want graphql error", err)
+		}
+		assertEqual(t, "messages", gErr.Messages, []string{"invalid argument"})
+	})
+
+	t.Run("http error", func(t *testing.T) {
+		c, _ := newServer(t, http.StatusTooManyRequests, `rate limited`)
+
+		_, err := c.Attestation(ctx, eas.HexDecodeUID(testUID))
+		var hErr *graphql.HTTPError
+		if !errors.As(err, &hErr) {
+			t.Fatalf("got error %v, want http error", err)
+		}
+		assertEqual(t, "status code", hErr.StatusCode, http.StatusTooManyRequests)
+		assertEqual(t, "body", hErr.Body, "rate limited")
+	})
+}
+
+func TestClient_Schemas(t *testing.T) {
+	ctx := context.Background()
+
+	c, got := newServer(t, http.StatusOK, `{"data": {"schemata": [{
+		"id": "`+testSchemaUID+`",
+		"schema": "string message",
+		"resolver": "0x0000000000000000000000000000000000000000",
+		"revocable": true
+	}]}}`)
+
+	schemas, err := c.Schemas(ctx, &graphql.SchemasQuery{
+		Creators: []common.Address{common.HexToAddress(testRecipient)},
+		Take:     1,
+	})
+	assertNilError(t, err)
+	assertJSONEqual(t, "variables", got.Variables, `{
+		"where": {"creator": {"in": ["`+testRecipient+`"]}},
+		"take": 1
+	}`)
+	assertEqual(t, "schemas", schemas, []*eas.SchemaRecord{{
+		UID:       eas.HexDecodeUID(testSchemaUID),
+		Revocable: true,
+		Schema:    "string message",
+	}})
+}
+
+func TestClient_OffchainRevocations(t *testing.T) {
+	ctx := context.Background()
+
+	txHash := "0x3333333333333333333333333333333333333333333333333333333333333333"
+	c, got := newServer(t, http.StatusOK, `{"data": {"offchainRevocations": [{
+		"uid": "`+testUID+`",
+		"from": "`+testRecipient+`",
+		"timestamp": 1710000000,
+		"txid": "`+txHash+`"
+	}]}}`)
+
+	revocations, err := c.OffchainRevocations(ctx, &graphql.OffchainRevocationsQuery{
+		UIDs: []eas.UID{eas.HexDecodeUID(testUID)},
+	})
+	assertNilError(t, err)
+	assertJSONEqual(t, "variables", got.Variables, `{
+		"where": {"uid": {"in": ["`+testUID+`"]}}
+	}`)
+	assertEqual(t, "revocations", revocations, []*graphql.OffchainRevocation{{
+		UID:     eas.HexDecodeUID(testUID),
+		Revoker: common.HexToAddress(testRecipient),
+		Time:    time.Unix(1710000000, 0),
+		TxHash:  common.HexToHash(txHash),
+	}})
+}
+
+func assertJSONEqual(t testing.TB, name string, got any, want string) {
+	t.Helper()
+
+	var w any
+	if err := json.Unmarshal([]byte(want), &w); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, name, got, w)
+}
+
+func assertNilError(t testing.TB, err error) {
+	t.Helper()
+
+	if err != nil {
+		t.Fatalf("got error %[1]T %[1]q", err)
+	}
+}
+
+func assertErrorIs(t testing.TB, err, target error) {
+	t.Helper()
+
+	if !errors.Is(err, target) {
+		t.Errorf("got error %v, want %v", err, target)
+	}
+}
+
+func assertEqual[T any](t testing.TB, name string, got, want T) {
+	t.Helper()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %s %+v, want %+v", name, got, want)
+	}
+}
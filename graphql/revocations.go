@@ -0,0 +1,86 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphql
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"resenje.org/eas"
+)
+
+// OffchainRevocation is a revocation of an offchain attestation.
+type OffchainRevocation struct {
+	UID     eas.UID
+	Revoker common.Address
+	Time    time.Time
+	TxHash  common.Hash
+}
+
+// OffchainRevocationsQuery selects offchain revocations. Revocations match
+// if they match all set fields, and any of the values of a field.
+type OffchainRevocationsQuery struct {
+	UIDs     []eas.UID
+	Revokers []common.Address
+	// From and To limit the revocation time. From is inclusive and To is
+	// exclusive.
+	From time.Time
+	To   time.Time
+	// OrderBy orders revocations by fields of the API, such as "timestamp".
+	OrderBy []Order
+	// Skip is the number of skipped revocations and Take is the maximal
+	// number of returned revocations, if not zero.
+	Skip int
+	Take int
+}
+
+func (q *OffchainRevocationsQuery) where() map[string]any {
+	w := make(map[string]any)
+	if len(q.UIDs) > 0 {
+		w["uid"] = inFilter(q.UIDs)
+	}
+	if len(q.Revokers) > 0 {
+		w["from"] = inFilter(q.Revokers)
+	}
+	if t := timeFilter(q.From, q.To); t != nil {
+		w["timestamp"] = t
+	}
+	return w
+}
+
+type offchainRevocationResult struct {
+	UID       eas.UID        `json:"uid"`
+	From      common.Address `json:"from"`
+	Timestamp int64          `json:"timestamp"`
+	TxID      common.Hash    `json:"txid"`
+}
+
+// OffchainRevocations returns offchain revocations that match the query.
+func (c *Client) OffchainRevocations(ctx context.Context, q *OffchainRevocationsQuery) ([]*OffchainRevocation, error) {
+	if q == nil {
+		q = new(OffchainRevocationsQuery)
+	}
+	var r struct {
+		OffchainRevocations []offchainRevocationResult `json:"offchainRevocations"`
+	}
+	if err := c.Do(ctx, `query OffchainRevocations($where: OffchainRevocationWhereInput, $orderBy: [OffchainRevocationOrderByWithRelationInput!], $skip: Int, $take: Int) {
+  offchainRevocations(where: $where, orderBy: $orderBy, skip: $skip, take: $take) { uid from timestamp txid }
+}`, listVariables(q.where(), q.OrderBy, q.Skip, q.Take), &r); err != nil {
+		return nil, err
+	}
+	s := make([]*OffchainRevocation, 0, len(r.OffchainRevocations))
+	for _, r := range r.OffchainRevocations {
+		s = append(s, &OffchainRevocation{
+			UID:     r.UID,
+			Revoker: r.From,
+			Time:    time.Unix(r.Timestamp, 0),
+			TxHash:  r.TxID,
+		})
+	}
+	return s, nil
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package graphql
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"resenje.org/eas"
+)
+
+// SchemasQuery selects schemas. Schemas match if they match all set fields,
+// and any of the values of a field.
+type SchemasQuery struct {
+	Creators  []common.Address
+	Resolvers []common.Address
+	// OrderBy orders schemas by fields of the API, such as "time" or
+	// "index".
+	OrderBy []Order
+	// Skip is the number of skipped schemas and Take is the maximal number
+	// of returned schemas, if not zero.
+	Skip int
+	Take int
+}
+
+func (q *SchemasQuery) where() map[string]any {
+	w := make(map[string]any)
+	if len(q.Creators) > 0 {
+		w["creator"] = inFilter(q.Creators)
+	}
+	if len(q.Resolvers) > 0 {
+		w["resolver"] = inFilter(q.Resolvers)
+	}
+	return w
+}
+
+const schemaFields = `id schema resolver revocable`
+
+type schemaResult struct {
+	ID        eas.UID        `json:"id"`
+	Schema    string         `json:"schema"`
+	Resolver  common.Address `json:"resolver"`
+	Revocable bool           `json:"revocable"`
+}
+
+func (r *schemaResult) schemaRecord() *eas.SchemaRecord {
+	return &eas.SchemaRecord{
+		UID:       r.ID,
+		Resolver:  r.Resolver,
+		Revocable: r.Revocable,
+		Schema:    r.Schema,
+	}
+}
+
+// Schema returns the schema with the uid.
+func (c *Client) Schema(ctx context.Context, uid eas.UID) (*eas.SchemaRecord, error) {
+	var r struct {
+		Schema *schemaResult `json:"schema"`
+	}
+	if err := c.Do(ctx, `query Schema($where: SchemaWhereUniqueInput!) {
+  schema(where: $where) { `+schemaFields+` }
+}`, map[string]any{
+		"where": map[string]any{"id": uid},
+	}, &r); err != nil {
+		return nil, err
+	}
+	if r.Schema == nil {
+		return nil, ErrNotFound
+	}
+	return r.Schema.schemaRecord(), nil
+}
+
+// Schemas returns schemas that match the query.
+func (c *Client) Schemas(ctx context.Context, q *SchemasQuery) ([]*eas.SchemaRecord, error) {
+	if q == nil {
+		q = new(SchemasQuery)
+	}
+	var r struct {
+		Schemas []schemaResult `json:"schemata"`
+	}
+	if err := c.Do(ctx, `query Schemas($where: SchemaWhereInput, $orderBy: [SchemaOrderByWithRelationInput!], $skip: Int, $take: Int) {
+  schemata(where: $where, orderBy: $orderBy, skip: $skip, take: $take) { `+schemaFields+` }
+}`, listVariables(q.where(), q.OrderBy, q.Skip, q.Take), &r); err != nil {
+		return nil, err
+	}
+	s := make([]*eas.SchemaRecord, 0, len(r.Schemas))
+	for _, r := range r.Schemas {
+		s = append(s, r.schemaRecord())
+	}
+	return s, nil
+}
@@ -0,0 +1,249 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+
+	"resenje.org/eas/internal/contracts"
+)
+
+type IndexerIndexed struct {
+	UID UID
+	Raw types.Log
+}
+
+func newIndexerIndexed(r *contracts.IndexerIndexed) *IndexerIndexed {
+	return &IndexerIndexed{
+		UID: r.Uid,
+		Raw: r.Raw,
+	}
+}
+
+// IndexerContract indexes attestations by recipient, attester and schema with
+// the EAS Indexer contract. Any account can index any existing attestation.
+type IndexerContract struct {
+	client   *Client
+	address  common.Address
+	contract *contracts.Indexer
+	abi      *abi.ABI
+}
+
+// NewIndexerContract constructs the Indexer contract on the provided address.
+func NewIndexerContract(client *Client, address common.Address) (*IndexerContract, error) {
+	contract, err := contracts.NewIndexer(address, client.backend)
+	if err != nil {
+		return nil, fmt.Errorf("construct abi bindings: %w", err)
+	}
+
+	abi, err := contracts.IndexerMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("get abi: %w", err)
+	}
+
+	return &IndexerContract{
+		client:   client,
+		address:  address,
+		contract: contract,
+		abi:      abi,
+	}, nil
+}
+
+func (c *IndexerContract) unpackError(err error) error {
+	return unpackError(err, c.client.errorABIsFor(c.abi, common.Address{})...)
+}
+
+func (c *IndexerContract) Address() common.Address {
+	return c.address
+}
+
+func (c *IndexerContract) Version(ctx context.Context) (string, error) {
+	v, err := c.contract.Version(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return "", c.unpackError(err)
+	}
+	return v, nil
+}
+
+// IndexAttestation indexes the attestation. Already indexed attestations are
+// skipped without emitting an Indexed event.
+func (c *IndexerContract) IndexAttestation(ctx context.Context, attestationUID UID) (*types.Transaction, WaitTx[IndexerIndexed], error) {
+	txOpts, err := c.newTxOpts(ctx, "indexAttestation", attestationUID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := c.contract.IndexAttestation(txOpts, attestationUID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("call indexAttestation contract method: %w", c.unpackError(err))
+	}
+
+	return tx, c.WaitIndexAttestation(tx), nil
+}
+
+// WaitIndexAttestation returns the function that waits for the attestation
+// indexing transaction to be mined, regardless of the account that sent it.
+func (c *IndexerContract) WaitIndexAttestation(tx *types.Transaction) WaitTx[IndexerIndexed] {
+	return newWaitTx(tx, c.client, c.address, c.abi.Events["Indexed"], newParseProxy(c.contract.ParseIndexed, newIndexerIndexed))
+}
+
+// IndexAttestations indexes multiple attestations in a single transaction.
+// Already indexed attestations are skipped without emitting Indexed events.
+func (c *IndexerContract) IndexAttestations(ctx context.Context, attestationUIDs []UID) (*types.Transaction, WaitTxMulti[IndexerIndexed], error) {
+	uids := castUIDSlice(attestationUIDs)
+
+	txOpts, err := c.newTxOpts(ctx, "indexAttestations", uids)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := c.contract.IndexAttestations(txOpts, uids)
+	if err != nil {
+		return nil, nil, fmt.Errorf("call indexAttestations contract method: %w", c.unpackError(err))
+	}
+
+	return tx, c.WaitIndexAttestations(tx), nil
+}
+
+// WaitIndexAttestations returns the function that waits for the multiple
+// attestations indexing transaction to be mined, regardless of the account
+// that sent it.
+func (c *IndexerContract) WaitIndexAttestations(tx *types.Transaction) WaitTxMulti[IndexerIndexed] {
+	return newWaitTxMulti(tx, c.client, c.address, c.abi.Events["Indexed"], newParseProxy(c.contract.ParseIndexed, newIndexerIndexed))
+}
+
+func (c *IndexerContract) newTxOpts(ctx context.Context, method string, args ...any) (*bind.TransactOpts, error) {
+	if c.client.options.Simulate {
+		if _, err := c.client.simulate(ctx, c.address, c.abi, nil, method, args...); err != nil {
+			return nil, fmt.Errorf("simulate %s: %w", method, c.unpackError(err))
+		}
+	}
+
+	txOpts, err := c.client.newTxOpts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("construct transaction options: %w", err)
+	}
+
+	return txOpts, nil
+}
+
+func (c *IndexerContract) IsAttestationIndexed(ctx context.Context, attestationUID UID) (bool, error) {
+	indexed, err := c.contract.IsAttestationIndexed(&bind.CallOpts{Context: ctx}, attestationUID)
+	if err != nil {
+		return false, c.unpackError(err)
+	}
+	return indexed, nil
+}
+
+// GetReceivedAttestationUIDs returns at most length UIDs of indexed
+// attestations with the schema received by the recipient, starting from the
+// start offset, in the order of indexing or in the reverse order. ErrInvalidOffset
+// is returned if the start offset is not lower than the number of attestations.
+func (c *IndexerContract) GetReceivedAttestationUIDs(ctx context.Context, recipient common.Address, schemaUID UID, start, length uint64, reverseOrder bool) ([]UID, error) {
+	uids, err := c.contract.GetReceivedAttestationUIDs(&bind.CallOpts{Context: ctx}, recipient, schemaUID, new(big.Int).SetUint64(start), new(big.Int).SetUint64(length), reverseOrder)
+	if err != nil {
+		return nil, c.unpackError(err)
+	}
+	return toUIDSlice(uids), nil
+}
+
+func (c *IndexerContract) GetReceivedAttestationUIDCount(ctx context.Context, recipient common.Address, schemaUID UID) (uint64, error) {
+	count, err := c.contract.GetReceivedAttestationUIDCount(&bind.CallOpts{Context: ctx}, recipient, schemaUID)
+	if err != nil {
+		return 0, c.unpackError(err)
+	}
+	return count.Uint64(), nil
+}
+
+// GetSentAttestationUIDs returns at most length UIDs of indexed attestations
+// with the schema sent by the attester, starting from the start offset, in the
+// order of indexing or in the reverse order.
+func (c *IndexerContract) GetSentAttestationUIDs(ctx context.Context, attester common.Address, schemaUID UID, start, length uint64, reverseOrder bool) ([]UID, error) {
+	uids, err := c.contract.GetSentAttestationUIDs(&bind.CallOpts{Context: ctx}, attester, schemaUID, new(big.Int).SetUint64(start), new(big.Int).SetUint64(length), reverseOrder)
+	if err != nil {
+		return nil, c.unpackError(err)
+	}
+	return toUIDSlice(uids), nil
+}
+
+func (c *IndexerContract) GetSentAttestationUIDCount(ctx context.Context, attester common.Address, schemaUID UID) (uint64, error) {
+	count, err := c.contract.GetSentAttestationUIDCount(&bind.CallOpts{Context: ctx}, attester, schemaUID)
+	if err != nil {
+		return 0, c.unpackError(err)
+	}
+	return count.Uint64(), nil
+}
+
+// GetSchemaAttesterRecipientAttestationUIDs returns at most length UIDs of
+// indexed attestations with the schema, the attester and the recipient,
+// starting from the start offset, in the order of indexing or in the reverse
+// order.
+func (c *IndexerContract) GetSchemaAttesterRecipientAttestationUIDs(ctx context.Context, schemaUID UID, attester, recipient common.Address, start, length uint64, reverseOrder bool) ([]UID, error) {
+	uids, err := c.contract.GetSchemaAttesterRecipientAttestationUIDs(&bind.CallOpts{Context: ctx}, schemaUID, attester, recipient, new(big.Int).SetUint64(start), new(big.Int).SetUint64(length), reverseOrder)
+	if err != nil {
+		return nil, c.unpackError(err)
+	}
+	return toUIDSlice(uids), nil
+}
+
+func (c *IndexerContract) GetSchemaAttesterRecipientAttestationUIDCount(ctx context.Context, schemaUID UID, attester, recipient common.Address) (uint64, error) {
+	count, err := c.contract.GetSchemaAttesterRecipientAttestationUIDCount(&bind.CallOpts{Context: ctx}, schemaUID, attester, recipient)
+	if err != nil {
+		return 0, c.unpackError(err)
+	}
+	return count.Uint64(), nil
+}
+
+// GetSchemaAttestationUIDs returns at most length UIDs of indexed attestations
+// with the schema, starting from the start offset, in the order of indexing or
+// in the reverse order.
+func (c *IndexerContract) GetSchemaAttestationUIDs(ctx context.Context, schemaUID UID, start, length uint64, reverseOrder bool) ([]UID, error) {
+	uids, err := c.contract.GetSchemaAttestationUIDs(&bind.CallOpts{Context: ctx}, schemaUID, new(big.Int).SetUint64(start), new(big.Int).SetUint64(length), reverseOrder)
+	if err != nil {
+		return nil, c.unpackError(err)
+	}
+	return toUIDSlice(uids), nil
+}
+
+func (c *IndexerContract) GetSchemaAttestationUIDCount(ctx context.Context, schemaUID UID) (uint64, error) {
+	count, err := c.contract.GetSchemaAttestationUIDCount(&bind.CallOpts{Context: ctx}, schemaUID)
+	if err != nil {
+		return 0, c.unpackError(err)
+	}
+	return count.Uint64(), nil
+}
+
+func (c *IndexerContract) FilterIndexed(ctx context.Context, start uint64, end *uint64, uids []UID) (Iterator[*IndexerIndexed], error) {
+	return newFilterIterator(ctx, c.client, c.address, c.abi.Events["Indexed"], c.client.filterStart(start), end,
+		[][]any{filterRule(castUIDSlice(uids))},
+		func(l types.Log) (*IndexerIndexed, error) {
+			e, err := c.contract.ParseIndexed(l)
+			if err != nil {
+				return nil, err
+			}
+			return newIndexerIndexed(e), nil
+		})
+}
+
+func (c *IndexerContract) WatchIndexed(ctx context.Context, start *uint64, sink chan<- *IndexerIndexed, uids []UID) (event.Subscription, error) {
+	return watchLogs(ctx, c.client, c.address, c.abi.Events["Indexed"], start,
+		[][]any{filterRule(castUIDSlice(uids))},
+		newParseProxy(c.contract.ParseIndexed, newIndexerIndexed), sink)
+}
+
+func (c *IndexerContract) StreamIndexed(ctx context.Context, o *StreamOptions, sink chan<- StreamEvent[*IndexerIndexed], uids []UID) (event.Subscription, error) {
+	return streamLogs(ctx, c.client, c.address, c.abi.Events["Indexed"], o,
+		[][]any{filterRule(castUIDSlice(uids))},
+		newParseProxy(c.contract.ParseIndexed, newIndexerIndexed), sink)
+}
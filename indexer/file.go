@@ -0,0 +1,311 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package indexer
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"resenje.org/eas"
+	"resenje.org/eas/consumer"
+)
+
+// maxJournalEntrySize limits the size of a single journal line.
+const maxJournalEntrySize = 64 * 1024 * 1024
+
+// FileStore keeps indexed records in memory and persists every change to a
+// journal file, which is replayed and compacted when the store is opened.
+type FileStore struct {
+	memory *MemoryStore
+	path   string
+	file   *os.File
+	mu     sync.Mutex
+}
+
+type journalOp string
+
+const (
+	opSaveCheckpoint           journalOp = "saveCheckpoint"
+	opPutSchema                journalOp = "putSchema"
+	opDeleteSchema             journalOp = "deleteSchema"
+	opPutAttestation           journalOp = "putAttestation"
+	opDeleteAttestation        journalOp = "deleteAttestation"
+	opPutOffchainRevocation    journalOp = "putOffchainRevocation"
+	opDeleteOffchainRevocation journalOp = "deleteOffchainRevocation"
+)
+
+type journalEntry struct {
+	Op          journalOp            `json:"op"`
+	Checkpoint  *consumer.Checkpoint `json:"checkpoint,omitempty"`
+	Schema      *eas.SchemaRecord    `json:"schema,omitempty"`
+	Attestation *Attestation         `json:"attestation,omitempty"`
+	Revocation  *OffchainRevocation  `json:"revocation,omitempty"`
+	UID         *eas.UID             `json:"uid,omitempty"`
+	Revoker     *common.Address      `json:"revoker,omitempty"`
+}
+
+// OpenFileStore opens the store with the journal file at the path, creating
+// it if it does not exist. The directory of the file must exist.
+func OpenFileStore(path string) (*FileStore, error) {
+	s := &FileStore{
+		memory: NewMemoryStore(),
+		path:   path,
+	}
+
+	if err := s.replay(); err != nil {
+		return nil, err
+	}
+	if err := s.compact(); err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open journal file: %w", err)
+	}
+	s.file = f
+	return s, nil
+}
+
+// Close closes the journal file.
+func (s *FileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.file.Close()
+}
+
+// replay applies all entries of the journal file to the memory store. An
+// incomplete last entry, written before a crash, is ignored.
+func (s *FileStore) replay() error {
+	f, err := os.Open(s.path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("open journal file: %w", err)
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	for line := 1; ; line++ {
+		data, err := readLine(r)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read journal file: %w", err)
+		}
+
+		var e journalEntry
+		if err := json.Unmarshal(data, &e); err != nil {
+			if _, err := r.Peek(1); errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("decode journal entry on line %v: %w", line, err)
+		}
+		if err := s.apply(e); err != nil {
+			return fmt.Errorf("apply journal entry on line %v: %w", line, err)
+		}
+	}
+}
+
+func readLine(r *bufio.Reader) ([]byte, error) {
+	var line []byte
+	for {
+		part, err := r.ReadSlice('\n')
+		line = append(line, part...)
+		if len(line) > maxJournalEntrySize {
+			return nil, errors.New("journal entry too large")
+		}
+		if errors.Is(err, bufio.ErrBufferFull) {
+			continue
+		}
+		if errors.Is(err, io.EOF) && len(line) > 0 {
+			return line, nil
+		}
+		return bytes.TrimSuffix(line, []byte("\n")), err
+	}
+}
+
+// compact replaces the journal file with entries of the current state.
+func (s *FileStore) compact() error {
+	m := s.memory
+
+	var entries []journalEntry
+	if m.checkpoint != nil {
+		entries = append(entries, journalEntry{Op: opSaveCheckpoint, Checkpoint: m.checkpoint})
+	}
+	// schemas are written before attestations to decode their values on
+	// replay
+	for _, r := range m.schemas {
+		entries = append(entries, journalEntry{Op: opPutSchema, Schema: r})
+	}
+	attestations := make([]*Attestation, 0, len(m.attestations))
+	for _, a := range m.attestations {
+		attestations = append(attestations, a)
+	}
+	slices.SortFunc(attestations, compareAttestations)
+	for _, a := range attestations {
+		entries = append(entries, journalEntry{Op: opPutAttestation, Attestation: a})
+	}
+	for _, r := range m.revocations {
+		entries = append(entries, journalEntry{Op: opPutOffchainRevocation, Revocation: r})
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("create journal file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, e := range entries {
+		if err := enc.Encode(e); err != nil {
+			f.Close()
+			return fmt.Errorf("write journal file: %w", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return fmt.Errorf("write journal file: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("sync journal file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("close journal file: %w", err)
+	}
+	if err := os.Rename(f.Name(), s.path); err != nil {
+		return fmt.Errorf("rename journal file: %w", err)
+	}
+	return nil
+}
+
+// write appends the entry to the journal file and applies it to the memory
+// store.
+func (s *FileStore) write(e journalEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("encode journal entry: %w", err)
+	}
+	if _, err := s.file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("write journal file: %w", err)
+	}
+	if err := s.file.Sync(); err != nil {
+		return fmt.Errorf("sync journal file: %w", err)
+	}
+	return s.apply(e)
+}
+
+func (s *FileStore) apply(e journalEntry) error {
+	ctx := context.Background()
+	switch e.Op {
+	case opSaveCheckpoint:
+		if e.Checkpoint != nil {
+			return s.memory.Save(ctx, *e.Checkpoint)
+		}
+	case opPutSchema:
+		if e.Schema != nil {
+			return s.memory.PutSchema(ctx, e.Schema)
+		}
+	case opDeleteSchema:
+		if e.UID != nil {
+			return s.memory.DeleteSchema(ctx, *e.UID)
+		}
+	case opPutAttestation:
+		if e.Attestation != nil {
+			a := *e.Attestation
+			if a.Values == nil {
+				// values are not encoded in the journal
+				if r, err := s.memory.Schema(ctx, a.Schema); err == nil {
+					a.Values, _ = eas.DecodeSchemaValues(r.Schema, a.Data)
+				}
+			}
+			return s.memory.PutAttestation(ctx, &a)
+		}
+	case opDeleteAttestation:
+		if e.UID != nil {
+			return s.memory.DeleteAttestation(ctx, *e.UID)
+		}
+	case opPutOffchainRevocation:
+		if e.Revocation != nil {
+			return s.memory.PutOffchainRevocation(ctx, e.Revocation)
+		}
+	case opDeleteOffchainRevocation:
+		if e.Revoker != nil && e.UID != nil {
+			return s.memory.DeleteOffchainRevocation(ctx, *e.Revoker, *e.UID)
+		}
+	default:
+		return fmt.Errorf("unknown operation %q", e.Op)
+	}
+	return fmt.Errorf("invalid %s entry", e.Op)
+}
+
+func (s *FileStore) Load(ctx context.Context) (*consumer.Checkpoint, error) {
+	return s.memory.Load(ctx)
+}
+
+func (s *FileStore) Save(_ context.Context, c consumer.Checkpoint) error {
+	return s.write(journalEntry{Op: opSaveCheckpoint, Checkpoint: &c})
+}
+
+func (s *FileStore) PutSchema(_ context.Context, r *eas.SchemaRecord) error {
+	return s.write(journalEntry{Op: opPutSchema, Schema: r})
+}
+
+func (s *FileStore) DeleteSchema(_ context.Context, uid eas.UID) error {
+	return s.write(journalEntry{Op: opDeleteSchema, UID: &uid})
+}
+
+func (s *FileStore) Schema(ctx context.Context, uid eas.UID) (*eas.SchemaRecord, error) {
+	return s.memory.Schema(ctx, uid)
+}
+
+func (s *FileStore) PutAttestation(_ context.Context, a *Attestation) error {
+	return s.write(journalEntry{Op: opPutAttestation, Attestation: a})
+}
+
+func (s *FileStore) DeleteAttestation(_ context.Context, uid eas.UID) error {
+	return s.write(journalEntry{Op: opDeleteAttestation, UID: &uid})
+}
+
+func (s *FileStore) Attestation(ctx context.Context, uid eas.UID) (*Attestation, error) {
+	return s.memory.Attestation(ctx, uid)
+}
+
+func (s *FileStore) Attestations(ctx context.Context, q *Query) ([]*Attestation, error) {
+	return s.memory.Attestations(ctx, q)
+}
+
+func (s *FileStore) PutOffchainRevocation(_ context.Context, r *OffchainRevocation) error {
+	return s.write(journalEntry{Op: opPutOffchainRevocation, Revocation: r})
+}
+
+func (s *FileStore) DeleteOffchainRevocation(_ context.Context, revoker common.Address, uid eas.UID) error {
+	return s.write(journalEntry{Op: opDeleteOffchainRevocation, Revoker: &revoker, UID: &uid})
+}
+
+func (s *FileStore) OffchainRevocation(ctx context.Context, revoker common.Address, uid eas.UID) (*OffchainRevocation, error) {
+	return s.memory.OffchainRevocation(ctx, revoker, uid)
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package indexer provides a local index of EAS attestations, schemas and
+// offchain revocations that can be queried without an external indexing
+// service.
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"resenje.org/eas"
+	"resenje.org/eas/consumer"
+)
+
+// Options configure the Indexer.
+type Options struct {
+	// Schemas limit indexed attestations to the ones with these schemas. If
+	// set, only schemas of indexed attestations are indexed, and offchain
+	// revocations are not indexed.
+	Schemas []eas.UID
+	// Start is the first indexed block if there is no saved checkpoint in
+	// the store.
+	Start uint64
+	// Confirmations is the number of blocks that have to be added after the
+	// block of the event before the event is indexed.
+	Confirmations uint64
+	// ReorgDepth is the number of latest blocks that are checked for reorgs.
+	// Default is 64.
+	ReorgDepth uint64
+}
+
+// Indexer follows Attested, Revoked, RevokedOffchain and Registered events
+// and stores full attestation and schema records in the store. Only
+// attestations with indexed Attested events are stored and updated by their
+// revocations.
+type Indexer struct {
+	client   *eas.Client
+	store    Store
+	consumer *consumer.Consumer
+}
+
+// New returns a new Indexer of events of the client.
+func New(client *eas.Client, store Store, o *Options) *Indexer {
+	if o == nil {
+		o = new(Options)
+	}
+
+	filter := &eas.EventFilter{
+		Types: []eas.EventType{
+			eas.EventAttested,
+			eas.EventRevoked,
+			eas.EventRevokedOffchain,
+			eas.EventRegistered,
+		},
+	}
+	if len(o.Schemas) > 0 {
+		filter = &eas.EventFilter{
+			Schemas: o.Schemas,
+		}
+	}
+
+	i := &Indexer{
+		client: client,
+		store:  store,
+	}
+	i.consumer = consumer.New(client, store, i.handle, &consumer.Options{
+		Filter:        filter,
+		Start:         o.Start,
+		Confirmations: o.Confirmations,
+		ReorgDepth:    o.ReorgDepth,
+	})
+	return i
+}
+
+// Run indexes events from the checkpoint saved in the store until the
+// context is done or indexing fails. It returns nil if the context is done.
+func (i *Indexer) Run(ctx context.Context) error {
+	return i.consumer.Run(ctx)
+}
+
+func (i *Indexer) handle(ctx context.Context, e eas.StreamEvent[eas.Event]) error {
+	removed := e.Type == eas.StreamEventRemoved
+
+	switch v := e.Value; v.Type {
+	case eas.EventRegistered:
+		if removed {
+			return i.store.DeleteSchema(ctx, v.Registered.UID)
+		}
+		_, err := i.indexSchema(ctx, v.Registered.UID)
+		return err
+
+	case eas.EventAttested:
+		if removed {
+			return i.store.DeleteAttestation(ctx, v.Attested.UID)
+		}
+		return i.indexAttestation(ctx, v.Attested.UID, e.Log.BlockNumber, e.Log.Index)
+
+	case eas.EventRevoked:
+		// the attestation is updated with its current revocation time for
+		// both added and removed revocations
+		a, err := i.store.Attestation(ctx, v.Revoked.UID)
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("get indexed attestation %s: %w", v.Revoked.UID, err)
+		}
+		return i.indexAttestation(ctx, a.UID, a.BlockNumber, a.LogIndex)
+
+	case eas.EventRevokedOffchain:
+		if removed {
+			return i.store.DeleteOffchainRevocation(ctx, v.RevokedOffchain.Revoker, v.RevokedOffchain.Data)
+		}
+		return i.store.PutOffchainRevocation(ctx, &OffchainRevocation{
+			Revoker: v.RevokedOffchain.Revoker,
+			UID:     v.RevokedOffchain.Data,
+			Time:    v.RevokedOffchain.Timestamp,
+		})
+	}
+	return nil
+}
+
+// indexSchema gets the schema record from the contract and stores it.
+func (i *Indexer) indexSchema(ctx context.Context, uid eas.UID) (*eas.SchemaRecord, error) {
+	r, err := i.client.SchemaRegistry.GetSchema(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("get schema %s: %w", uid, err)
+	}
+	if err := i.store.PutSchema(ctx, r); err != nil {
+		return nil, fmt.Errorf("store schema %s: %w", uid, err)
+	}
+	return r, nil
+}
+
+// indexAttestation gets the attestation from the contract, decodes its data
+// and stores it.
+func (i *Indexer) indexAttestation(ctx context.Context, uid eas.UID, blockNumber uint64, logIndex uint) error {
+	a, err := i.client.EAS.GetAttestation(ctx, uid)
+	if err != nil {
+		return fmt.Errorf("get attestation %s: %w", uid, err)
+	}
+
+	schema, err := i.store.Schema(ctx, a.Schema)
+	if errors.Is(err, ErrNotFound) {
+		schema, err = i.indexSchema(ctx, a.Schema)
+	}
+	if err != nil {
+		return fmt.Errorf("get attestation %s schema: %w", uid, err)
+	}
+
+	// data that does not match the schema is stored without decoded values
+	values, _ := eas.DecodeSchemaValues(schema.Schema, a.Data)
+
+	if err := i.store.PutAttestation(ctx, &Attestation{
+		Attestation: *a,
+		Values:      values,
+		BlockNumber: blockNumber,
+		LogIndex:    logIndex,
+	}); err != nil {
+		return fmt.Errorf("store attestation %s: %w", uid, err)
+	}
+	return nil
+}
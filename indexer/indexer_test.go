@@ -0,0 +1,343 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package indexer_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"resenje.org/eas"
+	"resenje.org/eas/eastest"
+	"resenje.org/eas/indexer"
+)
+
+const testSchema = "string name, uint256 score"
+
+func TestIndexer(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, &eastest.Options{AutoCommit: true})
+
+	c, err := eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EAS, &eas.Options{
+		Backend:           h.Backend(),
+		WatchPollInterval: 10 * time.Millisecond,
+	})
+	assertNilError(t, err)
+
+	_, wait, err := c.SchemaRegistry.Register(ctx, testSchema, [20]byte{}, true)
+	assertNilError(t, err)
+	r, err := wait(ctx)
+	assertNilError(t, err)
+	schemaUID := r.UID
+
+	alice := common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	bob := common.HexToAddress("0x00000000000000000000000000000000000000b0")
+
+	attest := func(t *testing.T, o *eas.AttestOptions, name string, score float64) eas.UID {
+		t.Helper()
+
+		data, err := eas.EncodeSchemaValues(testSchema, map[string]any{
+			"name":  name,
+			"score": score,
+		})
+		assertNilError(t, err)
+		_, wait, err := c.EAS.Attest(ctx, schemaUID, o, eas.EncodedData(data))
+		assertNilError(t, err)
+		r, err := wait(ctx)
+		assertNilError(t, err)
+		return r.UID
+	}
+
+	aliceFirst := attest(t, &eas.AttestOptions{Recipient: alice, Revocable: true}, "first", 1)
+	aliceRevoked := attest(t, &eas.AttestOptions{Recipient: alice, Revocable: true}, "revoked", 2)
+	aliceExpiring := attest(t, &eas.AttestOptions{Recipient: alice, ExpirationTime: time.Now().Add(time.Hour)}, "expiring", 3)
+	bobRef := attest(t, &eas.AttestOptions{Recipient: bob, RefUID: aliceFirst}, "ref", 1)
+
+	_, waitRevoke, err := c.EAS.Revoke(ctx, schemaUID, aliceRevoked, nil)
+	assertNilError(t, err)
+	_, err = waitRevoke(ctx)
+	assertNilError(t, err)
+
+	offchainUID := eas.UID{1, 2, 3}
+	_, waitRevokeOffchain, err := c.EAS.RevokeOffchain(ctx, offchainUID)
+	assertNilError(t, err)
+	_, err = waitRevokeOffchain(ctx)
+	assertNilError(t, err)
+
+	path := filepath.Join(t.TempDir(), "index.jsonl")
+	store, err := indexer.OpenFileStore(path)
+	assertNilError(t, err)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	errC := make(chan error, 1)
+	go func() {
+		errC <- indexer.New(c, store, nil).Run(runCtx)
+	}()
+
+	// wait for the last event to be indexed
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		_, err := store.OffchainRevocation(ctx, c.Address(), offchainUID)
+		if err == nil {
+			break
+		}
+		assertErrorIs(t, err, indexer.ErrNotFound)
+		if time.Now().After(deadline) {
+			t.Fatal("timeout")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancel()
+	assertNilError(t, <-errC)
+	assertNilError(t, store.Close())
+
+	// all records are loaded from the journal
+	store, err = indexer.OpenFileStore(path)
+	assertNilError(t, err)
+	defer store.Close()
+
+	query := func(t *testing.T, q *indexer.Query) []eas.UID {
+		t.Helper()
+
+		s, err := store.Attestations(ctx, q)
+		assertNilError(t, err)
+		uids := make([]eas.UID, 0, len(s))
+		for _, a := range s {
+			uids = append(uids, a.UID)
+		}
+		return uids
+	}
+
+	s, err := store.Schema(ctx, schemaUID)
+	assertNilError(t, err)
+	assertEqual(t, "schema", s.Schema, testSchema)
+
+	a, err := store.Attestation(ctx, aliceRevoked)
+	assertNilError(t, err)
+	assertEqual(t, "revoked", a.IsRevoked(), true)
+	assertEqual(t, "values", len(a.Values), 2)
+	assertEqual(t, "name", a.Values[0].Value, any("revoked"))
+
+	for _, tc := range []struct {
+		name  string
+		query *indexer.Query
+		want  []eas.UID
+	}{
+		{
+			name: "all",
+			want: []eas.UID{aliceFirst, aliceRevoked, aliceExpiring, bobRef},
+		},
+		{
+			name:  "recipient",
+			query: &indexer.Query{Recipients: []common.Address{alice}},
+			want:  []eas.UID{aliceFirst, aliceRevoked, aliceExpiring},
+		},
+		{
+			name:  "valid",
+			query: &indexer.Query{Recipients: []common.Address{alice}, Valid: true},
+			want:  []eas.UID{aliceFirst, aliceExpiring},
+		},
+		{
+			name:  "valid after expiration",
+			query: &indexer.Query{Recipients: []common.Address{alice}, Valid: true, ValidAt: time.Now().Add(2 * time.Hour)},
+			want:  []eas.UID{aliceFirst},
+		},
+		{
+			name:  "attester and schema",
+			query: &indexer.Query{Attesters: []common.Address{c.Address()}, Schemas: []eas.UID{schemaUID}},
+			want:  []eas.UID{aliceFirst, aliceRevoked, aliceExpiring, bobRef},
+		},
+		{
+			name:  "ref uid",
+			query: &indexer.Query{RefUIDs: []eas.UID{aliceFirst}},
+			want:  []eas.UID{bobRef},
+		},
+		{
+			name:  "field",
+			query: &indexer.Query{Fields: []indexer.Field{{Name: "score", Value: 1.0}}},
+			want:  []eas.UID{aliceFirst, bobRef},
+		},
+		{
+			name:  "fields",
+			query: &indexer.Query{Fields: []indexer.Field{{Name: "score", Value: "1"}, {Name: "name", Value: "ref"}}},
+			want:  []eas.UID{bobRef},
+		},
+		{
+			name:  "time range",
+			query: &indexer.Query{From: time.Now().Add(time.Hour)},
+			want:  []eas.UID{},
+		},
+		{
+			name:  "pagination",
+			query: &indexer.Query{Reverse: true, Offset: 1, Limit: 2},
+			want:  []eas.UID{aliceExpiring, aliceRevoked},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assertEqual(t, "uids", query(t, tc.query), tc.want)
+		})
+	}
+}
+
+func TestIndexer_reorg(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, &eastest.Options{
+		AutoCommit: true,
+		Faults:     true,
+	})
+
+	c, err := eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EAS, &eas.Options{
+		Backend:           h.Backend(),
+		WatchPollInterval: 10 * time.Millisecond,
+	})
+	assertNilError(t, err)
+
+	_, wait, err := c.SchemaRegistry.Register(ctx, testSchema, [20]byte{}, true)
+	assertNilError(t, err)
+	r, err := wait(ctx)
+	assertNilError(t, err)
+	schemaUID := r.UID
+
+	store := indexer.NewMemoryStore()
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	errC := make(chan error, 1)
+	go func() {
+		errC <- indexer.New(c, store, nil).Run(runCtx)
+	}()
+
+	// waitAttestations waits for the indexed attestations that are not in
+	// the excluded ones
+	waitAttestations := func(t *testing.T, exclude []*indexer.Attestation) []*indexer.Attestation {
+		t.Helper()
+
+		deadline := time.Now().Add(5 * time.Second)
+		for time.Now().Before(deadline) {
+			s, err := store.Attestations(ctx, nil)
+			assertNilError(t, err)
+			if len(s) == 2 && !slices.ContainsFunc(s, func(a *indexer.Attestation) bool {
+				return slices.ContainsFunc(exclude, func(e *indexer.Attestation) bool {
+					return e.UID == a.UID
+				})
+			}) {
+				return s
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatal("timeout")
+		return nil
+	}
+
+	// empty blocks before attestations
+	for range 3 {
+		h.Commit()
+	}
+
+	for _, name := range []string{"one", "two"} {
+		data, err := eas.EncodeSchemaValues(testSchema, map[string]any{
+			"name":  name,
+			"score": 1.0,
+		})
+		assertNilError(t, err)
+		_, wait, err := c.EAS.Attest(ctx, schemaUID, nil, eas.EncodedData(data))
+		assertNilError(t, err)
+		_, err = wait(ctx)
+		assertNilError(t, err)
+	}
+
+	before := waitAttestations(t, nil)
+
+	// blocks after the schema registration are replaced and attestations
+	// are included in a new block before blocks of removed events, with
+	// different uids as they depend on the block time
+	latest, err := h.Simulated.Client().BlockNumber(ctx)
+	assertNilError(t, err)
+	assertNilError(t, h.Faults.Reorg(ctx, latest+1-r.Raw.BlockNumber))
+
+	after := waitAttestations(t, before)
+	cancel()
+	assertNilError(t, <-errC)
+
+	for i, a := range before {
+		_, err := store.Attestation(ctx, a.UID)
+		assertErrorIs(t, err, indexer.ErrNotFound)
+
+		want, err := c.EAS.GetAttestation(ctx, after[i].UID)
+		assertNilError(t, err)
+		assertEqual(t, "attestation", after[i].Attestation, *want)
+		assertEqual(t, "name", after[i].Values[0].Value, before[i].Values[0].Value)
+		if after[i].BlockNumber >= a.BlockNumber {
+			t.Errorf("got block number %v, want before %v", after[i].BlockNumber, a.BlockNumber)
+		}
+	}
+
+	s, err := store.Schema(ctx, schemaUID)
+	assertNilError(t, err)
+	assertEqual(t, "schema", s.Schema, testSchema)
+}
+
+func TestMemoryStore_reorg(t *testing.T) {
+	ctx := context.Background()
+
+	s := indexer.NewMemoryStore()
+
+	a := &indexer.Attestation{
+		Attestation: eas.Attestation{
+			UID:       eas.UID{1},
+			Recipient: common.Address{1},
+		},
+	}
+	assertNilError(t, s.PutAttestation(ctx, a))
+
+	// the recipient index is updated on replacement
+	a.Recipient = common.Address{2}
+	assertNilError(t, s.PutAttestation(ctx, a))
+
+	got, err := s.Attestations(ctx, &indexer.Query{Recipients: []common.Address{{1}}})
+	assertNilError(t, err)
+	assertEqual(t, "count", len(got), 0)
+
+	assertNilError(t, s.DeleteAttestation(ctx, a.UID))
+	_, err = s.Attestation(ctx, a.UID)
+	assertErrorIs(t, err, indexer.ErrNotFound)
+
+	got, err = s.Attestations(ctx, &indexer.Query{Recipients: []common.Address{{2}}})
+	assertNilError(t, err)
+	assertEqual(t, "count", len(got), 0)
+}
+
+func assertNilError(t testing.TB, err error) {
+	t.Helper()
+
+	if err != nil {
+		t.Fatalf("got error %[1]T %[1]q", err)
+	}
+}
+
+func assertErrorIs(t testing.TB, err, target error) {
+	t.Helper()
+
+	if !errors.Is(err, target) {
+		t.Errorf("got error %v, want %v", err, target)
+	}
+}
+
+func assertEqual[T any](t testing.TB, name string, got, want T) {
+	t.Helper()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %s %+v, want %+v", name, got, want)
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package indexer
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"resenje.org/eas"
+	"resenje.org/eas/consumer"
+)
+
+// MemoryStore keeps indexed records in memory, with attestations indexed by
+// recipient, attester and schema.
+type MemoryStore struct {
+	checkpoint   *consumer.Checkpoint
+	schemas      map[eas.UID]*eas.SchemaRecord
+	attestations map[eas.UID]*Attestation
+	byRecipient  map[common.Address]map[eas.UID]struct{}
+	byAttester   map[common.Address]map[eas.UID]struct{}
+	bySchema     map[eas.UID]map[eas.UID]struct{}
+	revocations  map[revocationKey]*OffchainRevocation
+	mu           sync.RWMutex
+}
+
+type revocationKey struct {
+	revoker common.Address
+	uid     eas.UID
+}
+
+// NewMemoryStore returns a new empty in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		schemas:      make(map[eas.UID]*eas.SchemaRecord),
+		attestations: make(map[eas.UID]*Attestation),
+		byRecipient:  make(map[common.Address]map[eas.UID]struct{}),
+		byAttester:   make(map[common.Address]map[eas.UID]struct{}),
+		bySchema:     make(map[eas.UID]map[eas.UID]struct{}),
+		revocations:  make(map[revocationKey]*OffchainRevocation),
+	}
+}
+
+func (s *MemoryStore) Load(_ context.Context) (*consumer.Checkpoint, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.checkpoint == nil {
+		return nil, nil
+	}
+	c := *s.checkpoint
+	return &c, nil
+}
+
+func (s *MemoryStore) Save(_ context.Context, c consumer.Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.checkpoint = &c
+	return nil
+}
+
+func (s *MemoryStore) PutSchema(_ context.Context, r *eas.SchemaRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := *r
+	s.schemas[r.UID] = &c
+	return nil
+}
+
+func (s *MemoryStore) DeleteSchema(_ context.Context, uid eas.UID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.schemas, uid)
+	return nil
+}
+
+func (s *MemoryStore) Schema(_ context.Context, uid eas.UID) (*eas.SchemaRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	r, ok := s.schemas[uid]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	c := *r
+	return &c, nil
+}
+
+func (s *MemoryStore) PutAttestation(_ context.Context, a *Attestation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deleteAttestation(a.UID)
+
+	c := *a
+	s.attestations[a.UID] = &c
+	addToIndex(s.byRecipient, a.Recipient, a.UID)
+	addToIndex(s.byAttester, a.Attester, a.UID)
+	addToIndex(s.bySchema, a.Schema, a.UID)
+	return nil
+}
+
+func (s *MemoryStore) DeleteAttestation(_ context.Context, uid eas.UID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deleteAttestation(uid)
+	return nil
+}
+
+func (s *MemoryStore) deleteAttestation(uid eas.UID) {
+	a, ok := s.attestations[uid]
+	if !ok {
+		return
+	}
+	delete(s.attestations, uid)
+	removeFromIndex(s.byRecipient, a.Recipient, uid)
+	removeFromIndex(s.byAttester, a.Attester, uid)
+	removeFromIndex(s.bySchema, a.Schema, uid)
+}
+
+func (s *MemoryStore) Attestation(_ context.Context, uid eas.UID) (*Attestation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	a, ok := s.attestations[uid]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	c := *a
+	return &c, nil
+}
+
+func (s *MemoryStore) Attestations(_ context.Context, q *Query) ([]*Attestation, error) {
+	if q == nil {
+		q = new(Query)
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var result []*Attestation
+	add := func(a *Attestation) {
+		if q.Match(a) {
+			c := *a
+			result = append(result, &c)
+		}
+	}
+
+	// only attestations from the smallest applicable index are matched
+	var candidates map[eas.UID]struct{}
+	narrow := func(c map[eas.UID]struct{}) {
+		if candidates == nil || len(c) < len(candidates) {
+			candidates = c
+		}
+	}
+	if len(q.Recipients) > 0 {
+		narrow(unionIndex(s.byRecipient, q.Recipients))
+	}
+	if len(q.Attesters) > 0 {
+		narrow(unionIndex(s.byAttester, q.Attesters))
+	}
+	if len(q.Schemas) > 0 {
+		narrow(unionIndex(s.bySchema, q.Schemas))
+	}
+
+	if candidates != nil {
+		for uid := range candidates {
+			add(s.attestations[uid])
+		}
+	} else {
+		for _, a := range s.attestations {
+			add(a)
+		}
+	}
+
+	return paginate(result, q), nil
+}
+
+func (s *MemoryStore) PutOffchainRevocation(_ context.Context, r *OffchainRevocation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c := *r
+	s.revocations[revocationKey{revoker: r.Revoker, uid: r.UID}] = &c
+	return nil
+}
+
+func (s *MemoryStore) DeleteOffchainRevocation(_ context.Context, revoker common.Address, uid eas.UID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.revocations, revocationKey{revoker: revoker, uid: uid})
+	return nil
+}
+
+func (s *MemoryStore) OffchainRevocation(_ context.Context, revoker common.Address, uid eas.UID) (*OffchainRevocation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	r, ok := s.revocations[revocationKey{revoker: revoker, uid: uid}]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	c := *r
+	return &c, nil
+}
+
+func addToIndex[K comparable](index map[K]map[eas.UID]struct{}, key K, uid eas.UID) {
+	uids, ok := index[key]
+	if !ok {
+		uids = make(map[eas.UID]struct{})
+		index[key] = uids
+	}
+	uids[uid] = struct{}{}
+}
+
+func removeFromIndex[K comparable](index map[K]map[eas.UID]struct{}, key K, uid eas.UID) {
+	uids, ok := index[key]
+	if !ok {
+		return
+	}
+	delete(uids, uid)
+	if len(uids) == 0 {
+		delete(index, key)
+	}
+}
+
+func unionIndex[K comparable](index map[K]map[eas.UID]struct{}, keys []K) map[eas.UID]struct{} {
+	if len(keys) == 1 {
+		if uids, ok := index[keys[0]]; ok {
+			return uids
+		}
+		return map[eas.UID]struct{}{}
+	}
+	u := make(map[eas.UID]struct{})
+	for _, k := range keys {
+		for uid := range index[k] {
+			u[uid] = struct{}{}
+		}
+	}
+	return u
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"resenje.org/eas"
+	"resenje.org/eas/consumer"
+)
+
+// ErrNotFound is returned by stores if the requested record is not stored.
+var ErrNotFound = errors.New("not found")
+
+// Attestation is an indexed attestation.
+type Attestation struct {
+	eas.Attestation
+	// Values are attestation data decoded according to the schema, or nil if
+	// the data could not be decoded.
+	Values []eas.SchemaValue `json:"-"`
+	// BlockNumber and LogIndex are the position of the Attested event.
+	BlockNumber uint64
+	LogIndex    uint
+}
+
+// IsValid reports if the attestation is not revoked and not expired at the
+// time.
+func (a *Attestation) IsValid(at time.Time) bool {
+	if a.IsRevoked() {
+		return false
+	}
+	return a.ExpirationTime.Unix() == 0 || a.ExpirationTime.After(at)
+}
+
+// OffchainRevocation is an indexed revocation of an offchain attestation.
+type OffchainRevocation struct {
+	Revoker common.Address
+	UID     eas.UID
+	Time    time.Time
+}
+
+// Store persists indexed schemas, attestations and offchain revocations, and
+// the checkpoint of indexed events.
+type Store interface {
+	consumer.CheckpointStore
+
+	PutSchema(ctx context.Context, s *eas.SchemaRecord) error
+	DeleteSchema(ctx context.Context, uid eas.UID) error
+	Schema(ctx context.Context, uid eas.UID) (*eas.SchemaRecord, error)
+
+	PutAttestation(ctx context.Context, a *Attestation) error
+	DeleteAttestation(ctx context.Context, uid eas.UID) error
+	Attestation(ctx context.Context, uid eas.UID) (*Attestation, error)
+	// Attestations returns attestations that match the query, ordered by
+	// positions of their Attested events.
+	Attestations(ctx context.Context, q *Query) ([]*Attestation, error)
+
+	PutOffchainRevocation(ctx context.Context, r *OffchainRevocation) error
+	DeleteOffchainRevocation(ctx context.Context, revoker common.Address, uid eas.UID) error
+	OffchainRevocation(ctx context.Context, revoker common.Address, uid eas.UID) (*OffchainRevocation, error)
+}
+
+// Query selects attestations. Attestations match if they match all set
+// fields, and any of the values of a field.
+type Query struct {
+	Recipients []common.Address
+	Attesters  []common.Address
+	Schemas    []eas.UID
+	RefUIDs    []eas.UID
+	// From and To limit the attestation time. From is inclusive and To is
+	// exclusive.
+	From time.Time
+	To   time.Time
+	// Valid selects only attestations that are not revoked and not expired
+	// at the ValidAt time, or the current time if it is not set.
+	Valid   bool
+	ValidAt time.Time
+	// Fields selects attestations by values of decoded data fields.
+	Fields []Field
+	// Offset is the number of matched attestations that are skipped and
+	// Limit is the maximal number of returned attestations, if not zero.
+	Offset int
+	Limit  int
+	// Reverse returns attestations from the latest one.
+	Reverse bool
+}
+
+// Field is a value of a decoded attestation data field. Values are compared
+// in the form that is accepted by eas.EncodeSchemaValues, such as hex
+// strings for addresses and bytes, and numbers or decimal strings for
+// integers.
+type Field struct {
+	Name  string
+	Value any
+}
+
+// Match reports if the attestation matches the query.
+func (q *Query) Match(a *Attestation) bool {
+	if len(q.Recipients) > 0 && !slices.Contains(q.Recipients, a.Recipient) {
+		return false
+	}
+	if len(q.Attesters) > 0 && !slices.Contains(q.Attesters, a.Attester) {
+		return false
+	}
+	if len(q.Schemas) > 0 && !slices.Contains(q.Schemas, a.Schema) {
+		return false
+	}
+	if len(q.RefUIDs) > 0 && !slices.Contains(q.RefUIDs, a.RefUID) {
+		return false
+	}
+	if !q.From.IsZero() && a.Time.Before(q.From) {
+		return false
+	}
+	if !q.To.IsZero() && !a.Time.Before(q.To) {
+		return false
+	}
+	if q.Valid {
+		at := q.ValidAt
+		if at.IsZero() {
+			at = time.Now()
+		}
+		if !a.IsValid(at) {
+			return false
+		}
+	}
+	for _, f := range q.Fields {
+		if !matchField(a.Values, f) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchField(values []eas.SchemaValue, f Field) bool {
+	for _, v := range values {
+		if v.Name != f.Name {
+			continue
+		}
+		got, err := json.Marshal(v.Value)
+		if err != nil {
+			return false
+		}
+		want, err := json.Marshal(normalizeFieldValue(v.Type, f.Value))
+		if err != nil {
+			return false
+		}
+		return bytes.Equal(got, want)
+	}
+	return false
+}
+
+// normalizeFieldValue converts the value to the form of decoded values of the
+// type by encoding and decoding it. The value is returned as is if it can not
+// be encoded.
+func normalizeFieldValue(typ string, value any) any {
+	schema := typ + " value"
+	data, err := eas.EncodeSchemaValues(schema, map[string]any{"value": value})
+	if err != nil {
+		return value
+	}
+	values, err := eas.DecodeSchemaValues(schema, data)
+	if err != nil || len(values) != 1 {
+		return value
+	}
+	return values[0].Value
+}
+
+// paginate orders attestations and applies the offset and the limit of the
+// query.
+func paginate(s []*Attestation, q *Query) []*Attestation {
+	slices.SortFunc(s, func(a, b *Attestation) int {
+		c := compareAttestations(a, b)
+		if q.Reverse {
+			return -c
+		}
+		return c
+	})
+	if q.Offset > 0 {
+		if q.Offset >= len(s) {
+			return nil
+		}
+		s = s[q.Offset:]
+	}
+	if q.Limit > 0 && len(s) > q.Limit {
+		s = s[:q.Limit]
+	}
+	return s
+}
+
+func compareAttestations(a, b *Attestation) int {
+	switch {
+	case a.BlockNumber < b.BlockNumber:
+		return -1
+	case a.BlockNumber > b.BlockNumber:
+		return 1
+	case a.LogIndex < b.LogIndex:
+		return -1
+	case a.LogIndex > b.LogIndex:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"resenje.org/eas"
+	"resenje.org/eas/eastest"
+)
+
+func TestIndexerContract(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, &eastest.Options{Accounts: 2})
+	client := &Client{Client: h.Client(), account: h.Client().Address(), backend: h.Simulated}
+	other := &Client{Client: h.Accounts[1].Client, account: h.Accounts[1].Client.Address(), backend: h.Simulated}
+	indexer := client.Indexer
+
+	assertEqual(t, "address", indexer.Address(), h.Addresses.Indexer)
+
+	version, err := indexer.Version(ctx)
+	assertNilError(t, err)
+	assertEqual(t, "version", version, "1.3.0")
+
+	schemaUID := registerSchema(t, client, "string message")
+
+	recipient := common.HexToAddress("0x1234")
+
+	var sent []eas.UID
+	for i := range 3 {
+		sent = append(sent, attest(t, client, schemaUID, &eas.AttestOptions{Recipient: recipient}, fmt.Sprintf("message %v", i)))
+	}
+	otherUID := attest(t, other, schemaUID, &eas.AttestOptions{Recipient: recipient}, "other")
+
+	indexed, err := indexer.IsAttestationIndexed(ctx, sent[0])
+	assertNilError(t, err)
+	assertEqual(t, "indexed", indexed, false)
+
+	_, wait, err := indexer.IndexAttestation(ctx, sent[0])
+	assertNilError(t, err)
+
+	h.Commit()
+
+	r, err := wait(ctx)
+	assertNilError(t, err)
+	assertEqual(t, "uid", r.UID, sent[0])
+
+	indexed, err = indexer.IsAttestationIndexed(ctx, sent[0])
+	assertNilError(t, err)
+	assertEqual(t, "indexed", indexed, true)
+
+	// already indexed attestation is skipped
+	_, waitMulti, err := indexer.IndexAttestations(ctx, []eas.UID{sent[0], sent[1], sent[2], otherUID})
+	assertNilError(t, err)
+
+	h.Commit()
+
+	rs, err := waitMulti(ctx)
+	assertNilError(t, err)
+	var got []eas.UID
+	for _, r := range rs {
+		got = append(got, r.UID)
+	}
+	assertEqual(t, "indexed uids", got, []eas.UID{sent[1], sent[2], otherUID})
+
+	count, err := indexer.GetSentAttestationUIDCount(ctx, client.account, schemaUID)
+	assertNilError(t, err)
+	assertEqual(t, "sent count", count, uint64(3))
+
+	uids, err := indexer.GetSentAttestationUIDs(ctx, client.account, schemaUID, 0, 2, false)
+	assertNilError(t, err)
+	assertEqual(t, "sent", uids, sent[:2])
+
+	uids, err = indexer.GetSentAttestationUIDs(ctx, client.account, schemaUID, 1, 10, true)
+	assertNilError(t, err)
+	assertEqual(t, "sent reversed", uids, []eas.UID{sent[1], sent[0]})
+
+	count, err = indexer.GetReceivedAttestationUIDCount(ctx, recipient, schemaUID)
+	assertNilError(t, err)
+	assertEqual(t, "received count", count, uint64(4))
+
+	uids, err = indexer.GetReceivedAttestationUIDs(ctx, recipient, schemaUID, 3, 10, false)
+	assertNilError(t, err)
+	assertEqual(t, "received", uids, []eas.UID{otherUID})
+
+	count, err = indexer.GetSchemaAttesterRecipientAttestationUIDCount(ctx, schemaUID, other.account, recipient)
+	assertNilError(t, err)
+	assertEqual(t, "schema attester recipient count", count, uint64(1))
+
+	uids, err = indexer.GetSchemaAttesterRecipientAttestationUIDs(ctx, schemaUID, other.account, recipient, 0, 10, false)
+	assertNilError(t, err)
+	assertEqual(t, "schema attester recipient", uids, []eas.UID{otherUID})
+
+	count, err = indexer.GetSchemaAttestationUIDCount(ctx, schemaUID)
+	assertNilError(t, err)
+	assertEqual(t, "schema count", count, uint64(4))
+
+	uids, err = indexer.GetSchemaAttestationUIDs(ctx, schemaUID, 0, 1, true)
+	assertNilError(t, err)
+	assertEqual(t, "schema", uids, []eas.UID{otherUID})
+
+	uids, err = indexer.GetSchemaAttestationUIDs(ctx, eas.UID{1}, 0, 10, false)
+	assertNilError(t, err)
+	assertEqual(t, "unknown schema", len(uids), 0)
+
+	_, err = indexer.GetSchemaAttestationUIDs(ctx, schemaUID, 4, 10, false)
+	assertErrorIs(t, err, eas.ErrInvalidOffset)
+
+	_, _, err = indexer.IndexAttestation(ctx, eas.UID{1})
+	assertErrorIs(t, err, eas.ErrInvalidAttestation)
+
+	it, err := indexer.FilterIndexed(ctx, 0, nil, nil)
+	assertNilError(t, err)
+	defer it.Close()
+
+	got = nil
+	for it.Next() {
+		got = append(got, it.Value().UID)
+	}
+	assertNilError(t, it.Error())
+	assertEqual(t, "filtered uids", got, []eas.UID{sent[0], sent[1], sent[2], otherUID})
+}
@@ -0,0 +1,401 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// AttesterResolverMetaData contains all meta data concerning the AttesterResolver contract.
+var AttesterResolverMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"contractIEAS\",\"name\":\"eas\",\"type\":\"address\"},{\"internalType\":\"address[]\",\"name\":\"attesters\",\"type\":\"address[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"AccessDenied\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InsufficientValue\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidEAS\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidLength\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotPayable\",\"type\":\"error\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"schema\",\"type\":\"bytes32\"},{\"internalType\":\"uint64\",\"name\":\"time\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"expirationTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"revocationTime\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"refUID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"revocable\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structAttestation\",\"name\":\"attestation\",\"type\":\"tuple\"}],\"name\":\"attest\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"}],\"name\":\"isAllowed\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isPayable\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"schema\",\"type\":\"bytes32\"},{\"internalType\":\"uint64\",\"name\":\"time\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"expirationTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"revocationTime\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"refUID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"revocable\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structAttestation[]\",\"name\":\"attestations\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256[]\",\"name\":\"values\",\"type\":\"uint256[]\"}],\"name\":\"multiAttest\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"schema\",\"type\":\"bytes32\"},{\"internalType\":\"uint64\",\"name\":\"time\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"expirationTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"revocationTime\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"refUID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"revocable\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structAttestation[]\",\"name\":\"attestations\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256[]\",\"name\":\"values\",\"type\":\"uint256[]\"}],\"name\":\"multiRevoke\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"schema\",\"type\":\"bytes32\"},{\"internalType\":\"uint64\",\"name\":\"time\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"expirationTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"revocationTime\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"refUID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"revocable\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structAttestation\",\"name\":\"attestation\",\"type\":\"tuple\"}],\"name\":\"revoke\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"version\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
+	Bin: "0x60a060405234801561001057600080fd5b5060405161090838038061090883398101604081905261002f91610110565b816001600160a01b038116610057576040516341bc07ff60e11b815260040160405180910390fd5b6001600160a01b0316608052805160005b818110156100c9576001600080858481518110610087576100876101eb565b6020908102919091018101516001600160a01b03168252810191909152604001600020805460ff19169115159190911790556100c281610201565b9050610068565b50505050610228565b6001600160a01b03811681146100e757600080fd5b50565b634e487b7160e01b600052604160045260246000fd5b805161010b816100d2565b919050565b6000806040838503121561012357600080fd5b825161012e816100d2565b602084810151919350906001600160401b038082111561014d57600080fd5b818601915086601f83011261016157600080fd5b815181811115610173576101736100ea565b8060051b604051601f19603f83011681018181108582111715610198576101986100ea565b6040529182528482019250838101850191898311156101b657600080fd5b938501935b828510156101db576101cc85610100565b845293850193928501926101bb565b8096505050505050509250929050565b634e487b7160e01b600052603260045260246000fd5b60006001820161022157634e487b7160e01b600052601160045260246000fd5b5060010190565b6080516106b06102586000396000818161018a015281816102ac015281816103be015261041201526106b06000f3fe6080604052600436106100745760003560e01c8063babcc5391161004e578063babcc5391461010a578063ce46e04614610143578063e49617e114610157578063e60c35051461016a57600080fd5b806354fd4d501461009757806388e5b2d9146100d457806391db0b7e146100f757600080fd5b3661009257604051631574f9f360e01b815260040160405180910390fd5b600080fd5b3480156100a357600080fd5b5060408051808201825260058152640312e302e360dc1b602082015290516100cb919061048f565b60405180910390f35b6100e76100e2366004610529565b61017d565b60405190151581526020016100cb565b6100e7610105366004610529565b61029f565b34801561011657600080fd5b506100e7610125366004610595565b6001600160a01b031660009081526020819052604090205460ff1690565b34801561014f57600080fd5b5060006100e7565b6100e76101653660046105c5565b6103b1565b6100e76101783660046105c5565b610405565b6000336001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146101c857604051634ca8886760e01b815260040160405180910390fd5b838281146101e95760405163251f56a160e21b815260040160405180910390fd5b3460005b8281101561028f57600086868381811061020957610209610601565b905060200201359050828111156102335760405163044044a560e21b815260040160405180910390fd5b61026089898481811061024857610248610601565b905060200281019061025a9190610617565b50600190565b610271576000945050505050610297565b61027b818461064e565b9250508061028890610661565b90506101ed565b506001925050505b949350505050565b6000336001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146102ea57604051634ca8886760e01b815260040160405180910390fd5b8382811461030b5760405163251f56a160e21b815260040160405180910390fd5b3460005b8281101561028f57600086868381811061032b5761032b610601565b905060200201359050828111156103555760405163044044a560e21b815260040160405180910390fd5b61038289898481811061036a5761036a610601565b905060200281019061037c9190610617565b82610456565b610393576000945050505050610297565b61039d818461064e565b925050806103aa90610661565b905061030f565b6000336001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146103fc57604051634ca8886760e01b815260040160405180910390fd5b60015b92915050565b6000336001600160a01b037f0000000000000000000000000000000000000000000000000000000000000000161461045057604051634ca8886760e01b815260040160405180910390fd5b6103ff82345b6000808061046b610100860160e08701610595565b6001600160a01b0316815260208101919091526040016000205460ff169392505050565b600060208083528351808285015260005b818110156104bc578581018301518582016040015282016104a0565b506000604082860101526040601f19601f8301168501019250505092915050565b60008083601f8401126104ef57600080fd5b50813567ffffffffffffffff81111561050757600080fd5b6020830191508360208260051b850101111561052257600080fd5b9250929050565b6000806000806040858703121561053f57600080fd5b843567ffffffffffffffff8082111561055757600080fd5b610563888389016104dd565b9096509450602087013591508082111561057c57600080fd5b50610589878288016104dd565b95989497509550505050565b6000602082840312156105a757600080fd5b81356001600160a01b03811681146105be57600080fd5b9392505050565b6000602082840312156105d757600080fd5b813567ffffffffffffffff8111156105ee57600080fd5b820161014081850312156105be57600080fd5b634e487b7160e01b600052603260045260246000fd5b6000823561013e1983360301811261062e57600080fd5b9190910192915050565b634e487b7160e01b600052601160045260246000fd5b818103818111156103ff576103ff610638565b60006001820161067357610673610638565b506001019056fea26469706673582212207eaa60e4b2e40de826ecc78272db9b08e8848f0f6ea639afea8394bd592071c164736f6c63430008150033",
+}
+
+// AttesterResolverABI is the input ABI used to generate the binding from.
+// Deprecated: Use AttesterResolverMetaData.ABI instead.
+var AttesterResolverABI = AttesterResolverMetaData.ABI
+
+// AttesterResolverBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use AttesterResolverMetaData.Bin instead.
+var AttesterResolverBin = AttesterResolverMetaData.Bin
+
+// DeployAttesterResolver deploys a new Ethereum contract, binding an instance of AttesterResolver to it.
+func DeployAttesterResolver(auth *bind.TransactOpts, backend bind.ContractBackend, eas common.Address, attesters []common.Address) (common.Address, *types.Transaction, *AttesterResolver, error) {
+	parsed, err := AttesterResolverMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(AttesterResolverBin), backend, eas, attesters)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &AttesterResolver{AttesterResolverCaller: AttesterResolverCaller{contract: contract}, AttesterResolverTransactor: AttesterResolverTransactor{contract: contract}, AttesterResolverFilterer: AttesterResolverFilterer{contract: contract}}, nil
+}
+
+// AttesterResolver is an auto generated Go binding around an Ethereum contract.
+type AttesterResolver struct {
+	AttesterResolverCaller     // Read-only binding to the contract
+	AttesterResolverTransactor // Write-only binding to the contract
+	AttesterResolverFilterer   // Log filterer for contract events
+}
+
+// AttesterResolverCaller is an auto generated read-only Go binding around an Ethereum contract.
+type AttesterResolverCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AttesterResolverTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type AttesterResolverTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AttesterResolverFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type AttesterResolverFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AttesterResolverSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type AttesterResolverSession struct {
+	Contract     *AttesterResolver // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// AttesterResolverCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type AttesterResolverCallerSession struct {
+	Contract *AttesterResolverCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts           // Call options to use throughout this session
+}
+
+// AttesterResolverTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type AttesterResolverTransactorSession struct {
+	Contract     *AttesterResolverTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts           // Transaction auth options to use throughout this session
+}
+
+// AttesterResolverRaw is an auto generated low-level Go binding around an Ethereum contract.
+type AttesterResolverRaw struct {
+	Contract *AttesterResolver // Generic contract binding to access the raw methods on
+}
+
+// AttesterResolverCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type AttesterResolverCallerRaw struct {
+	Contract *AttesterResolverCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// AttesterResolverTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type AttesterResolverTransactorRaw struct {
+	Contract *AttesterResolverTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewAttesterResolver creates a new instance of AttesterResolver, bound to a specific deployed contract.
+func NewAttesterResolver(address common.Address, backend bind.ContractBackend) (*AttesterResolver, error) {
+	contract, err := bindAttesterResolver(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &AttesterResolver{AttesterResolverCaller: AttesterResolverCaller{contract: contract}, AttesterResolverTransactor: AttesterResolverTransactor{contract: contract}, AttesterResolverFilterer: AttesterResolverFilterer{contract: contract}}, nil
+}
+
+// NewAttesterResolverCaller creates a new read-only instance of AttesterResolver, bound to a specific deployed contract.
+func NewAttesterResolverCaller(address common.Address, caller bind.ContractCaller) (*AttesterResolverCaller, error) {
+	contract, err := bindAttesterResolver(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AttesterResolverCaller{contract: contract}, nil
+}
+
+// NewAttesterResolverTransactor creates a new write-only instance of AttesterResolver, bound to a specific deployed contract.
+func NewAttesterResolverTransactor(address common.Address, transactor bind.ContractTransactor) (*AttesterResolverTransactor, error) {
+	contract, err := bindAttesterResolver(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AttesterResolverTransactor{contract: contract}, nil
+}
+
+// NewAttesterResolverFilterer creates a new log filterer instance of AttesterResolver, bound to a specific deployed contract.
+func NewAttesterResolverFilterer(address common.Address, filterer bind.ContractFilterer) (*AttesterResolverFilterer, error) {
+	contract, err := bindAttesterResolver(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &AttesterResolverFilterer{contract: contract}, nil
+}
+
+// bindAttesterResolver binds a generic wrapper to an already deployed contract.
+func bindAttesterResolver(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := AttesterResolverMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_AttesterResolver *AttesterResolverRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _AttesterResolver.Contract.AttesterResolverCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_AttesterResolver *AttesterResolverRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AttesterResolver.Contract.AttesterResolverTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_AttesterResolver *AttesterResolverRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _AttesterResolver.Contract.AttesterResolverTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_AttesterResolver *AttesterResolverCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _AttesterResolver.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_AttesterResolver *AttesterResolverTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AttesterResolver.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_AttesterResolver *AttesterResolverTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _AttesterResolver.Contract.contract.Transact(opts, method, params...)
+}
+
+// IsAllowed is a free data retrieval call binding the contract method 0xbabcc539.
+//
+// Solidity: function isAllowed(address attester) view returns(bool)
+func (_AttesterResolver *AttesterResolverCaller) IsAllowed(opts *bind.CallOpts, attester common.Address) (bool, error) {
+	var out []interface{}
+	err := _AttesterResolver.contract.Call(opts, &out, "isAllowed", attester)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsAllowed is a free data retrieval call binding the contract method 0xbabcc539.
+//
+// Solidity: function isAllowed(address attester) view returns(bool)
+func (_AttesterResolver *AttesterResolverSession) IsAllowed(attester common.Address) (bool, error) {
+	return _AttesterResolver.Contract.IsAllowed(&_AttesterResolver.CallOpts, attester)
+}
+
+// IsAllowed is a free data retrieval call binding the contract method 0xbabcc539.
+//
+// Solidity: function isAllowed(address attester) view returns(bool)
+func (_AttesterResolver *AttesterResolverCallerSession) IsAllowed(attester common.Address) (bool, error) {
+	return _AttesterResolver.Contract.IsAllowed(&_AttesterResolver.CallOpts, attester)
+}
+
+// IsPayable is a free data retrieval call binding the contract method 0xce46e046.
+//
+// Solidity: function isPayable() pure returns(bool)
+func (_AttesterResolver *AttesterResolverCaller) IsPayable(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _AttesterResolver.contract.Call(opts, &out, "isPayable")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsPayable is a free data retrieval call binding the contract method 0xce46e046.
+//
+// Solidity: function isPayable() pure returns(bool)
+func (_AttesterResolver *AttesterResolverSession) IsPayable() (bool, error) {
+	return _AttesterResolver.Contract.IsPayable(&_AttesterResolver.CallOpts)
+}
+
+// IsPayable is a free data retrieval call binding the contract method 0xce46e046.
+//
+// Solidity: function isPayable() pure returns(bool)
+func (_AttesterResolver *AttesterResolverCallerSession) IsPayable() (bool, error) {
+	return _AttesterResolver.Contract.IsPayable(&_AttesterResolver.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(string)
+func (_AttesterResolver *AttesterResolverCaller) Version(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _AttesterResolver.contract.Call(opts, &out, "version")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(string)
+func (_AttesterResolver *AttesterResolverSession) Version() (string, error) {
+	return _AttesterResolver.Contract.Version(&_AttesterResolver.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(string)
+func (_AttesterResolver *AttesterResolverCallerSession) Version() (string, error) {
+	return _AttesterResolver.Contract.Version(&_AttesterResolver.CallOpts)
+}
+
+// Attest is a paid mutator transaction binding the contract method 0xe60c3505.
+//
+// Solidity: function attest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_AttesterResolver *AttesterResolverTransactor) Attest(opts *bind.TransactOpts, attestation Attestation) (*types.Transaction, error) {
+	return _AttesterResolver.contract.Transact(opts, "attest", attestation)
+}
+
+// Attest is a paid mutator transaction binding the contract method 0xe60c3505.
+//
+// Solidity: function attest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_AttesterResolver *AttesterResolverSession) Attest(attestation Attestation) (*types.Transaction, error) {
+	return _AttesterResolver.Contract.Attest(&_AttesterResolver.TransactOpts, attestation)
+}
+
+// Attest is a paid mutator transaction binding the contract method 0xe60c3505.
+//
+// Solidity: function attest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_AttesterResolver *AttesterResolverTransactorSession) Attest(attestation Attestation) (*types.Transaction, error) {
+	return _AttesterResolver.Contract.Attest(&_AttesterResolver.TransactOpts, attestation)
+}
+
+// MultiAttest is a paid mutator transaction binding the contract method 0x91db0b7e.
+//
+// Solidity: function multiAttest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_AttesterResolver *AttesterResolverTransactor) MultiAttest(opts *bind.TransactOpts, attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _AttesterResolver.contract.Transact(opts, "multiAttest", attestations, values)
+}
+
+// MultiAttest is a paid mutator transaction binding the contract method 0x91db0b7e.
+//
+// Solidity: function multiAttest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_AttesterResolver *AttesterResolverSession) MultiAttest(attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _AttesterResolver.Contract.MultiAttest(&_AttesterResolver.TransactOpts, attestations, values)
+}
+
+// MultiAttest is a paid mutator transaction binding the contract method 0x91db0b7e.
+//
+// Solidity: function multiAttest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_AttesterResolver *AttesterResolverTransactorSession) MultiAttest(attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _AttesterResolver.Contract.MultiAttest(&_AttesterResolver.TransactOpts, attestations, values)
+}
+
+// MultiRevoke is a paid mutator transaction binding the contract method 0x88e5b2d9.
+//
+// Solidity: function multiRevoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_AttesterResolver *AttesterResolverTransactor) MultiRevoke(opts *bind.TransactOpts, attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _AttesterResolver.contract.Transact(opts, "multiRevoke", attestations, values)
+}
+
+// MultiRevoke is a paid mutator transaction binding the contract method 0x88e5b2d9.
+//
+// Solidity: function multiRevoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_AttesterResolver *AttesterResolverSession) MultiRevoke(attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _AttesterResolver.Contract.MultiRevoke(&_AttesterResolver.TransactOpts, attestations, values)
+}
+
+// MultiRevoke is a paid mutator transaction binding the contract method 0x88e5b2d9.
+//
+// Solidity: function multiRevoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_AttesterResolver *AttesterResolverTransactorSession) MultiRevoke(attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _AttesterResolver.Contract.MultiRevoke(&_AttesterResolver.TransactOpts, attestations, values)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0xe49617e1.
+//
+// Solidity: function revoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_AttesterResolver *AttesterResolverTransactor) Revoke(opts *bind.TransactOpts, attestation Attestation) (*types.Transaction, error) {
+	return _AttesterResolver.contract.Transact(opts, "revoke", attestation)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0xe49617e1.
+//
+// Solidity: function revoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_AttesterResolver *AttesterResolverSession) Revoke(attestation Attestation) (*types.Transaction, error) {
+	return _AttesterResolver.Contract.Revoke(&_AttesterResolver.TransactOpts, attestation)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0xe49617e1.
+//
+// Solidity: function revoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_AttesterResolver *AttesterResolverTransactorSession) Revoke(attestation Attestation) (*types.Transaction, error) {
+	return _AttesterResolver.Contract.Revoke(&_AttesterResolver.TransactOpts, attestation)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_AttesterResolver *AttesterResolverTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AttesterResolver.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_AttesterResolver *AttesterResolverSession) Receive() (*types.Transaction, error) {
+	return _AttesterResolver.Contract.Receive(&_AttesterResolver.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_AttesterResolver *AttesterResolverTransactorSession) Receive() (*types.Transaction, error) {
+	return _AttesterResolver.Contract.Receive(&_AttesterResolver.TransactOpts)
+}
@@ -0,0 +1,401 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ExpirationTimeResolverMetaData contains all meta data concerning the ExpirationTimeResolver contract.
+var ExpirationTimeResolverMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"contractIEAS\",\"name\":\"eas\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"validAfter\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"AccessDenied\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InsufficientValue\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidEAS\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidLength\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotPayable\",\"type\":\"error\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"schema\",\"type\":\"bytes32\"},{\"internalType\":\"uint64\",\"name\":\"time\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"expirationTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"revocationTime\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"refUID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"revocable\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structAttestation\",\"name\":\"attestation\",\"type\":\"tuple\"}],\"name\":\"attest\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getValidAfter\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isPayable\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"schema\",\"type\":\"bytes32\"},{\"internalType\":\"uint64\",\"name\":\"time\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"expirationTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"revocationTime\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"refUID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"revocable\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structAttestation[]\",\"name\":\"attestations\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256[]\",\"name\":\"values\",\"type\":\"uint256[]\"}],\"name\":\"multiAttest\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"schema\",\"type\":\"bytes32\"},{\"internalType\":\"uint64\",\"name\":\"time\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"expirationTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"revocationTime\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"refUID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"revocable\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structAttestation[]\",\"name\":\"attestations\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256[]\",\"name\":\"values\",\"type\":\"uint256[]\"}],\"name\":\"multiRevoke\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"schema\",\"type\":\"bytes32\"},{\"internalType\":\"uint64\",\"name\":\"time\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"expirationTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"revocationTime\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"refUID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"revocable\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structAttestation\",\"name\":\"attestation\",\"type\":\"tuple\"}],\"name\":\"revoke\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"version\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
+	Bin: "0x60c060405234801561001057600080fd5b506040516107a23803806107a283398101604081905261002f9161006c565b816001600160a01b038116610057576040516341bc07ff60e11b815260040160405180910390fd5b6001600160a01b031660805260a052506100a6565b6000806040838503121561007f57600080fd5b82516001600160a01b038116811461009657600080fd5b6020939093015192949293505050565b60805160a0516106bc6100e66000396000818160a90152610458015260008181610188015281816102aa015281816103bc015261041001526106bc6000f3fe6080604052600436106100745760003560e01c806391db0b7e1161004e57806391db0b7e1461012e578063ce46e04614610141578063e49617e114610155578063e60c35051461016857600080fd5b80630c3667141461009757806354fd4d50146100d757806388e5b2d91461010b57600080fd5b3661009257604051631574f9f360e01b815260040160405180910390fd5b600080fd5b3480156100a357600080fd5b506040517f000000000000000000000000000000000000000000000000000000000000000081526020015b60405180910390f35b3480156100e357600080fd5b5060408051808201825260058152640312e302e360dc1b602082015290516100ce919061049a565b61011e610119366004610534565b61017b565b60405190151581526020016100ce565b61011e61013c366004610534565b61029d565b34801561014d57600080fd5b50600061011e565b61011e6101633660046105a0565b6103af565b61011e6101763660046105a0565b610403565b6000336001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146101c657604051634ca8886760e01b815260040160405180910390fd5b838281146101e75760405163251f56a160e21b815260040160405180910390fd5b3460005b8281101561028d576000868683818110610207576102076105e3565b905060200201359050828111156102315760405163044044a560e21b815260040160405180910390fd5b61025e898984818110610246576102466105e3565b905060200281019061025891906105f9565b50600190565b61026f576000945050505050610295565b6102798184610630565b9250508061028690610643565b90506101eb565b506001925050505b949350505050565b6000336001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146102e857604051634ca8886760e01b815260040160405180910390fd5b838281146103095760405163251f56a160e21b815260040160405180910390fd5b3460005b8281101561028d576000868683818110610329576103296105e3565b905060200201359050828111156103535760405163044044a560e21b815260040160405180910390fd5b610380898984818110610368576103686105e3565b905060200281019061037a91906105f9565b82610454565b610391576000945050505050610295565b61039b8184610630565b925050806103a890610643565b905061030d565b6000336001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146103fa57604051634ca8886760e01b815260040160405180910390fd5b60015b92915050565b6000336001600160a01b037f0000000000000000000000000000000000000000000000000000000000000000161461044e57604051634ca8886760e01b815260040160405180910390fd5b6103fd82345b60007f0000000000000000000000000000000000000000000000000000000000000000610487608085016060860161065c565b67ffffffffffffffff1610159392505050565b600060208083528351808285015260005b818110156104c7578581018301518582016040015282016104ab565b506000604082860101526040601f19601f8301168501019250505092915050565b60008083601f8401126104fa57600080fd5b50813567ffffffffffffffff81111561051257600080fd5b6020830191508360208260051b850101111561052d57600080fd5b9250929050565b6000806000806040858703121561054a57600080fd5b843567ffffffffffffffff8082111561056257600080fd5b61056e888389016104e8565b9096509450602087013591508082111561058757600080fd5b50610594878288016104e8565b95989497509550505050565b6000602082840312156105b257600080fd5b813567ffffffffffffffff8111156105c957600080fd5b820161014081850312156105dc57600080fd5b9392505050565b634e487b7160e01b600052603260045260246000fd5b6000823561013e1983360301811261061057600080fd5b9190910192915050565b634e487b7160e01b600052601160045260246000fd5b818103818111156103fd576103fd61061a565b6000600182016106555761065561061a565b5060010190565b60006020828403121561066e57600080fd5b813567ffffffffffffffff811681146105dc57600080fdfea2646970667358221220358575451b7cc28bc427ae783f0410044219746151d033833080dd3f1a261c1764736f6c63430008150033",
+}
+
+// ExpirationTimeResolverABI is the input ABI used to generate the binding from.
+// Deprecated: Use ExpirationTimeResolverMetaData.ABI instead.
+var ExpirationTimeResolverABI = ExpirationTimeResolverMetaData.ABI
+
+// ExpirationTimeResolverBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ExpirationTimeResolverMetaData.Bin instead.
+var ExpirationTimeResolverBin = ExpirationTimeResolverMetaData.Bin
+
+// DeployExpirationTimeResolver deploys a new Ethereum contract, binding an instance of ExpirationTimeResolver to it.
+func DeployExpirationTimeResolver(auth *bind.TransactOpts, backend bind.ContractBackend, eas common.Address, validAfter *big.Int) (common.Address, *types.Transaction, *ExpirationTimeResolver, error) {
+	parsed, err := ExpirationTimeResolverMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ExpirationTimeResolverBin), backend, eas, validAfter)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ExpirationTimeResolver{ExpirationTimeResolverCaller: ExpirationTimeResolverCaller{contract: contract}, ExpirationTimeResolverTransactor: ExpirationTimeResolverTransactor{contract: contract}, ExpirationTimeResolverFilterer: ExpirationTimeResolverFilterer{contract: contract}}, nil
+}
+
+// ExpirationTimeResolver is an auto generated Go binding around an Ethereum contract.
+type ExpirationTimeResolver struct {
+	ExpirationTimeResolverCaller     // Read-only binding to the contract
+	ExpirationTimeResolverTransactor // Write-only binding to the contract
+	ExpirationTimeResolverFilterer   // Log filterer for contract events
+}
+
+// ExpirationTimeResolverCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ExpirationTimeResolverCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ExpirationTimeResolverTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ExpirationTimeResolverTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ExpirationTimeResolverFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ExpirationTimeResolverFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ExpirationTimeResolverSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ExpirationTimeResolverSession struct {
+	Contract     *ExpirationTimeResolver // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts           // Call options to use throughout this session
+	TransactOpts bind.TransactOpts       // Transaction auth options to use throughout this session
+}
+
+// ExpirationTimeResolverCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ExpirationTimeResolverCallerSession struct {
+	Contract *ExpirationTimeResolverCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                 // Call options to use throughout this session
+}
+
+// ExpirationTimeResolverTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ExpirationTimeResolverTransactorSession struct {
+	Contract     *ExpirationTimeResolverTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                 // Transaction auth options to use throughout this session
+}
+
+// ExpirationTimeResolverRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ExpirationTimeResolverRaw struct {
+	Contract *ExpirationTimeResolver // Generic contract binding to access the raw methods on
+}
+
+// ExpirationTimeResolverCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ExpirationTimeResolverCallerRaw struct {
+	Contract *ExpirationTimeResolverCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ExpirationTimeResolverTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ExpirationTimeResolverTransactorRaw struct {
+	Contract *ExpirationTimeResolverTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewExpirationTimeResolver creates a new instance of ExpirationTimeResolver, bound to a specific deployed contract.
+func NewExpirationTimeResolver(address common.Address, backend bind.ContractBackend) (*ExpirationTimeResolver, error) {
+	contract, err := bindExpirationTimeResolver(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ExpirationTimeResolver{ExpirationTimeResolverCaller: ExpirationTimeResolverCaller{contract: contract}, ExpirationTimeResolverTransactor: ExpirationTimeResolverTransactor{contract: contract}, ExpirationTimeResolverFilterer: ExpirationTimeResolverFilterer{contract: contract}}, nil
+}
+
+// NewExpirationTimeResolverCaller creates a new read-only instance of ExpirationTimeResolver, bound to a specific deployed contract.
+func NewExpirationTimeResolverCaller(address common.Address, caller bind.ContractCaller) (*ExpirationTimeResolverCaller, error) {
+	contract, err := bindExpirationTimeResolver(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ExpirationTimeResolverCaller{contract: contract}, nil
+}
+
+// NewExpirationTimeResolverTransactor creates a new write-only instance of ExpirationTimeResolver, bound to a specific deployed contract.
+func NewExpirationTimeResolverTransactor(address common.Address, transactor bind.ContractTransactor) (*ExpirationTimeResolverTransactor, error) {
+	contract, err := bindExpirationTimeResolver(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ExpirationTimeResolverTransactor{contract: contract}, nil
+}
+
+// NewExpirationTimeResolverFilterer creates a new log filterer instance of ExpirationTimeResolver, bound to a specific deployed contract.
+func NewExpirationTimeResolverFilterer(address common.Address, filterer bind.ContractFilterer) (*ExpirationTimeResolverFilterer, error) {
+	contract, err := bindExpirationTimeResolver(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ExpirationTimeResolverFilterer{contract: contract}, nil
+}
+
+// bindExpirationTimeResolver binds a generic wrapper to an already deployed contract.
+func bindExpirationTimeResolver(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ExpirationTimeResolverMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ExpirationTimeResolver *ExpirationTimeResolverRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ExpirationTimeResolver.Contract.ExpirationTimeResolverCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ExpirationTimeResolver *ExpirationTimeResolverRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ExpirationTimeResolver.Contract.ExpirationTimeResolverTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ExpirationTimeResolver *ExpirationTimeResolverRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ExpirationTimeResolver.Contract.ExpirationTimeResolverTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ExpirationTimeResolver *ExpirationTimeResolverCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ExpirationTimeResolver.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ExpirationTimeResolver *ExpirationTimeResolverTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ExpirationTimeResolver.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ExpirationTimeResolver *ExpirationTimeResolverTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ExpirationTimeResolver.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetValidAfter is a free data retrieval call binding the contract method 0x0c366714.
+//
+// Solidity: function getValidAfter() view returns(uint256)
+func (_ExpirationTimeResolver *ExpirationTimeResolverCaller) GetValidAfter(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ExpirationTimeResolver.contract.Call(opts, &out, "getValidAfter")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetValidAfter is a free data retrieval call binding the contract method 0x0c366714.
+//
+// Solidity: function getValidAfter() view returns(uint256)
+func (_ExpirationTimeResolver *ExpirationTimeResolverSession) GetValidAfter() (*big.Int, error) {
+	return _ExpirationTimeResolver.Contract.GetValidAfter(&_ExpirationTimeResolver.CallOpts)
+}
+
+// GetValidAfter is a free data retrieval call binding the contract method 0x0c366714.
+//
+// Solidity: function getValidAfter() view returns(uint256)
+func (_ExpirationTimeResolver *ExpirationTimeResolverCallerSession) GetValidAfter() (*big.Int, error) {
+	return _ExpirationTimeResolver.Contract.GetValidAfter(&_ExpirationTimeResolver.CallOpts)
+}
+
+// IsPayable is a free data retrieval call binding the contract method 0xce46e046.
+//
+// Solidity: function isPayable() pure returns(bool)
+func (_ExpirationTimeResolver *ExpirationTimeResolverCaller) IsPayable(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _ExpirationTimeResolver.contract.Call(opts, &out, "isPayable")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsPayable is a free data retrieval call binding the contract method 0xce46e046.
+//
+// Solidity: function isPayable() pure returns(bool)
+func (_ExpirationTimeResolver *ExpirationTimeResolverSession) IsPayable() (bool, error) {
+	return _ExpirationTimeResolver.Contract.IsPayable(&_ExpirationTimeResolver.CallOpts)
+}
+
+// IsPayable is a free data retrieval call binding the contract method 0xce46e046.
+//
+// Solidity: function isPayable() pure returns(bool)
+func (_ExpirationTimeResolver *ExpirationTimeResolverCallerSession) IsPayable() (bool, error) {
+	return _ExpirationTimeResolver.Contract.IsPayable(&_ExpirationTimeResolver.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(string)
+func (_ExpirationTimeResolver *ExpirationTimeResolverCaller) Version(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ExpirationTimeResolver.contract.Call(opts, &out, "version")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(string)
+func (_ExpirationTimeResolver *ExpirationTimeResolverSession) Version() (string, error) {
+	return _ExpirationTimeResolver.Contract.Version(&_ExpirationTimeResolver.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(string)
+func (_ExpirationTimeResolver *ExpirationTimeResolverCallerSession) Version() (string, error) {
+	return _ExpirationTimeResolver.Contract.Version(&_ExpirationTimeResolver.CallOpts)
+}
+
+// Attest is a paid mutator transaction binding the contract method 0xe60c3505.
+//
+// Solidity: function attest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_ExpirationTimeResolver *ExpirationTimeResolverTransactor) Attest(opts *bind.TransactOpts, attestation Attestation) (*types.Transaction, error) {
+	return _ExpirationTimeResolver.contract.Transact(opts, "attest", attestation)
+}
+
+// Attest is a paid mutator transaction binding the contract method 0xe60c3505.
+//
+// Solidity: function attest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_ExpirationTimeResolver *ExpirationTimeResolverSession) Attest(attestation Attestation) (*types.Transaction, error) {
+	return _ExpirationTimeResolver.Contract.Attest(&_ExpirationTimeResolver.TransactOpts, attestation)
+}
+
+// Attest is a paid mutator transaction binding the contract method 0xe60c3505.
+//
+// Solidity: function attest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_ExpirationTimeResolver *ExpirationTimeResolverTransactorSession) Attest(attestation Attestation) (*types.Transaction, error) {
+	return _ExpirationTimeResolver.Contract.Attest(&_ExpirationTimeResolver.TransactOpts, attestation)
+}
+
+// MultiAttest is a paid mutator transaction binding the contract method 0x91db0b7e.
+//
+// Solidity: function multiAttest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_ExpirationTimeResolver *ExpirationTimeResolverTransactor) MultiAttest(opts *bind.TransactOpts, attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _ExpirationTimeResolver.contract.Transact(opts, "multiAttest", attestations, values)
+}
+
+// MultiAttest is a paid mutator transaction binding the contract method 0x91db0b7e.
+//
+// Solidity: function multiAttest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_ExpirationTimeResolver *ExpirationTimeResolverSession) MultiAttest(attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _ExpirationTimeResolver.Contract.MultiAttest(&_ExpirationTimeResolver.TransactOpts, attestations, values)
+}
+
+// MultiAttest is a paid mutator transaction binding the contract method 0x91db0b7e.
+//
+// Solidity: function multiAttest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_ExpirationTimeResolver *ExpirationTimeResolverTransactorSession) MultiAttest(attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _ExpirationTimeResolver.Contract.MultiAttest(&_ExpirationTimeResolver.TransactOpts, attestations, values)
+}
+
+// MultiRevoke is a paid mutator transaction binding the contract method 0x88e5b2d9.
+//
+// Solidity: function multiRevoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_ExpirationTimeResolver *ExpirationTimeResolverTransactor) MultiRevoke(opts *bind.TransactOpts, attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _ExpirationTimeResolver.contract.Transact(opts, "multiRevoke", attestations, values)
+}
+
+// MultiRevoke is a paid mutator transaction binding the contract method 0x88e5b2d9.
+//
+// Solidity: function multiRevoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_ExpirationTimeResolver *ExpirationTimeResolverSession) MultiRevoke(attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _ExpirationTimeResolver.Contract.MultiRevoke(&_ExpirationTimeResolver.TransactOpts, attestations, values)
+}
+
+// MultiRevoke is a paid mutator transaction binding the contract method 0x88e5b2d9.
+//
+// Solidity: function multiRevoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_ExpirationTimeResolver *ExpirationTimeResolverTransactorSession) MultiRevoke(attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _ExpirationTimeResolver.Contract.MultiRevoke(&_ExpirationTimeResolver.TransactOpts, attestations, values)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0xe49617e1.
+//
+// Solidity: function revoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_ExpirationTimeResolver *ExpirationTimeResolverTransactor) Revoke(opts *bind.TransactOpts, attestation Attestation) (*types.Transaction, error) {
+	return _ExpirationTimeResolver.contract.Transact(opts, "revoke", attestation)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0xe49617e1.
+//
+// Solidity: function revoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_ExpirationTimeResolver *ExpirationTimeResolverSession) Revoke(attestation Attestation) (*types.Transaction, error) {
+	return _ExpirationTimeResolver.Contract.Revoke(&_ExpirationTimeResolver.TransactOpts, attestation)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0xe49617e1.
+//
+// Solidity: function revoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_ExpirationTimeResolver *ExpirationTimeResolverTransactorSession) Revoke(attestation Attestation) (*types.Transaction, error) {
+	return _ExpirationTimeResolver.Contract.Revoke(&_ExpirationTimeResolver.TransactOpts, attestation)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_ExpirationTimeResolver *ExpirationTimeResolverTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ExpirationTimeResolver.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_ExpirationTimeResolver *ExpirationTimeResolverSession) Receive() (*types.Transaction, error) {
+	return _ExpirationTimeResolver.Contract.Receive(&_ExpirationTimeResolver.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_ExpirationTimeResolver *ExpirationTimeResolverTransactorSession) Receive() (*types.Transaction, error) {
+	return _ExpirationTimeResolver.Contract.Receive(&_ExpirationTimeResolver.TransactOpts)
+}
@@ -0,0 +1,348 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// SchemaResolverMetaData contains all meta data concerning the SchemaResolver contract.
+var SchemaResolverMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"name\":\"AccessDenied\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InsufficientValue\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidEAS\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidLength\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotPayable\",\"type\":\"error\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"schema\",\"type\":\"bytes32\"},{\"internalType\":\"uint64\",\"name\":\"time\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"expirationTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"revocationTime\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"refUID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"revocable\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structAttestation\",\"name\":\"attestation\",\"type\":\"tuple\"}],\"name\":\"attest\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isPayable\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"schema\",\"type\":\"bytes32\"},{\"internalType\":\"uint64\",\"name\":\"time\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"expirationTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"revocationTime\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"refUID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"revocable\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structAttestation[]\",\"name\":\"attestations\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256[]\",\"name\":\"values\",\"type\":\"uint256[]\"}],\"name\":\"multiAttest\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"schema\",\"type\":\"bytes32\"},{\"internalType\":\"uint64\",\"name\":\"time\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"expirationTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"revocationTime\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"refUID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"revocable\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structAttestation[]\",\"name\":\"attestations\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256[]\",\"name\":\"values\",\"type\":\"uint256[]\"}],\"name\":\"multiRevoke\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"schema\",\"type\":\"bytes32\"},{\"internalType\":\"uint64\",\"name\":\"time\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"expirationTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"revocationTime\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"refUID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"revocable\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structAttestation\",\"name\":\"attestation\",\"type\":\"tuple\"}],\"name\":\"revoke\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"version\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
+}
+
+// SchemaResolverABI is the input ABI used to generate the binding from.
+// Deprecated: Use SchemaResolverMetaData.ABI instead.
+var SchemaResolverABI = SchemaResolverMetaData.ABI
+
+// SchemaResolver is an auto generated Go binding around an Ethereum contract.
+type SchemaResolver struct {
+	SchemaResolverCaller     // Read-only binding to the contract
+	SchemaResolverTransactor // Write-only binding to the contract
+	SchemaResolverFilterer   // Log filterer for contract events
+}
+
+// SchemaResolverCaller is an auto generated read-only Go binding around an Ethereum contract.
+type SchemaResolverCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SchemaResolverTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type SchemaResolverTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SchemaResolverFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type SchemaResolverFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SchemaResolverSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type SchemaResolverSession struct {
+	Contract     *SchemaResolver   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// SchemaResolverCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type SchemaResolverCallerSession struct {
+	Contract *SchemaResolverCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// SchemaResolverTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type SchemaResolverTransactorSession struct {
+	Contract     *SchemaResolverTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// SchemaResolverRaw is an auto generated low-level Go binding around an Ethereum contract.
+type SchemaResolverRaw struct {
+	Contract *SchemaResolver // Generic contract binding to access the raw methods on
+}
+
+// SchemaResolverCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type SchemaResolverCallerRaw struct {
+	Contract *SchemaResolverCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// SchemaResolverTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type SchemaResolverTransactorRaw struct {
+	Contract *SchemaResolverTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewSchemaResolver creates a new instance of SchemaResolver, bound to a specific deployed contract.
+func NewSchemaResolver(address common.Address, backend bind.ContractBackend) (*SchemaResolver, error) {
+	contract, err := bindSchemaResolver(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &SchemaResolver{SchemaResolverCaller: SchemaResolverCaller{contract: contract}, SchemaResolverTransactor: SchemaResolverTransactor{contract: contract}, SchemaResolverFilterer: SchemaResolverFilterer{contract: contract}}, nil
+}
+
+// NewSchemaResolverCaller creates a new read-only instance of SchemaResolver, bound to a specific deployed contract.
+func NewSchemaResolverCaller(address common.Address, caller bind.ContractCaller) (*SchemaResolverCaller, error) {
+	contract, err := bindSchemaResolver(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SchemaResolverCaller{contract: contract}, nil
+}
+
+// NewSchemaResolverTransactor creates a new write-only instance of SchemaResolver, bound to a specific deployed contract.
+func NewSchemaResolverTransactor(address common.Address, transactor bind.ContractTransactor) (*SchemaResolverTransactor, error) {
+	contract, err := bindSchemaResolver(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SchemaResolverTransactor{contract: contract}, nil
+}
+
+// NewSchemaResolverFilterer creates a new log filterer instance of SchemaResolver, bound to a specific deployed contract.
+func NewSchemaResolverFilterer(address common.Address, filterer bind.ContractFilterer) (*SchemaResolverFilterer, error) {
+	contract, err := bindSchemaResolver(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &SchemaResolverFilterer{contract: contract}, nil
+}
+
+// bindSchemaResolver binds a generic wrapper to an already deployed contract.
+func bindSchemaResolver(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := SchemaResolverMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SchemaResolver *SchemaResolverRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SchemaResolver.Contract.SchemaResolverCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SchemaResolver *SchemaResolverRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SchemaResolver.Contract.SchemaResolverTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SchemaResolver *SchemaResolverRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SchemaResolver.Contract.SchemaResolverTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SchemaResolver *SchemaResolverCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SchemaResolver.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SchemaResolver *SchemaResolverTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SchemaResolver.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SchemaResolver *SchemaResolverTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SchemaResolver.Contract.contract.Transact(opts, method, params...)
+}
+
+// IsPayable is a free data retrieval call binding the contract method 0xce46e046.
+//
+// Solidity: function isPayable() pure returns(bool)
+func (_SchemaResolver *SchemaResolverCaller) IsPayable(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _SchemaResolver.contract.Call(opts, &out, "isPayable")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsPayable is a free data retrieval call binding the contract method 0xce46e046.
+//
+// Solidity: function isPayable() pure returns(bool)
+func (_SchemaResolver *SchemaResolverSession) IsPayable() (bool, error) {
+	return _SchemaResolver.Contract.IsPayable(&_SchemaResolver.CallOpts)
+}
+
+// IsPayable is a free data retrieval call binding the contract method 0xce46e046.
+//
+// Solidity: function isPayable() pure returns(bool)
+func (_SchemaResolver *SchemaResolverCallerSession) IsPayable() (bool, error) {
+	return _SchemaResolver.Contract.IsPayable(&_SchemaResolver.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(string)
+func (_SchemaResolver *SchemaResolverCaller) Version(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _SchemaResolver.contract.Call(opts, &out, "version")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(string)
+func (_SchemaResolver *SchemaResolverSession) Version() (string, error) {
+	return _SchemaResolver.Contract.Version(&_SchemaResolver.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(string)
+func (_SchemaResolver *SchemaResolverCallerSession) Version() (string, error) {
+	return _SchemaResolver.Contract.Version(&_SchemaResolver.CallOpts)
+}
+
+// Attest is a paid mutator transaction binding the contract method 0xe60c3505.
+//
+// Solidity: function attest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_SchemaResolver *SchemaResolverTransactor) Attest(opts *bind.TransactOpts, attestation Attestation) (*types.Transaction, error) {
+	return _SchemaResolver.contract.Transact(opts, "attest", attestation)
+}
+
+// Attest is a paid mutator transaction binding the contract method 0xe60c3505.
+//
+// Solidity: function attest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_SchemaResolver *SchemaResolverSession) Attest(attestation Attestation) (*types.Transaction, error) {
+	return _SchemaResolver.Contract.Attest(&_SchemaResolver.TransactOpts, attestation)
+}
+
+// Attest is a paid mutator transaction binding the contract method 0xe60c3505.
+//
+// Solidity: function attest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_SchemaResolver *SchemaResolverTransactorSession) Attest(attestation Attestation) (*types.Transaction, error) {
+	return _SchemaResolver.Contract.Attest(&_SchemaResolver.TransactOpts, attestation)
+}
+
+// MultiAttest is a paid mutator transaction binding the contract method 0x91db0b7e.
+//
+// Solidity: function multiAttest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_SchemaResolver *SchemaResolverTransactor) MultiAttest(opts *bind.TransactOpts, attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _SchemaResolver.contract.Transact(opts, "multiAttest", attestations, values)
+}
+
+// MultiAttest is a paid mutator transaction binding the contract method 0x91db0b7e.
+//
+// Solidity: function multiAttest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_SchemaResolver *SchemaResolverSession) MultiAttest(attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _SchemaResolver.Contract.MultiAttest(&_SchemaResolver.TransactOpts, attestations, values)
+}
+
+// MultiAttest is a paid mutator transaction binding the contract method 0x91db0b7e.
+//
+// Solidity: function multiAttest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_SchemaResolver *SchemaResolverTransactorSession) MultiAttest(attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _SchemaResolver.Contract.MultiAttest(&_SchemaResolver.TransactOpts, attestations, values)
+}
+
+// MultiRevoke is a paid mutator transaction binding the contract method 0x88e5b2d9.
+//
+// Solidity: function multiRevoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_SchemaResolver *SchemaResolverTransactor) MultiRevoke(opts *bind.TransactOpts, attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _SchemaResolver.contract.Transact(opts, "multiRevoke", attestations, values)
+}
+
+// MultiRevoke is a paid mutator transaction binding the contract method 0x88e5b2d9.
+//
+// Solidity: function multiRevoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_SchemaResolver *SchemaResolverSession) MultiRevoke(attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _SchemaResolver.Contract.MultiRevoke(&_SchemaResolver.TransactOpts, attestations, values)
+}
+
+// MultiRevoke is a paid mutator transaction binding the contract method 0x88e5b2d9.
+//
+// Solidity: function multiRevoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_SchemaResolver *SchemaResolverTransactorSession) MultiRevoke(attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _SchemaResolver.Contract.MultiRevoke(&_SchemaResolver.TransactOpts, attestations, values)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0xe49617e1.
+//
+// Solidity: function revoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_SchemaResolver *SchemaResolverTransactor) Revoke(opts *bind.TransactOpts, attestation Attestation) (*types.Transaction, error) {
+	return _SchemaResolver.contract.Transact(opts, "revoke", attestation)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0xe49617e1.
+//
+// Solidity: function revoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_SchemaResolver *SchemaResolverSession) Revoke(attestation Attestation) (*types.Transaction, error) {
+	return _SchemaResolver.Contract.Revoke(&_SchemaResolver.TransactOpts, attestation)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0xe49617e1.
+//
+// Solidity: function revoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_SchemaResolver *SchemaResolverTransactorSession) Revoke(attestation Attestation) (*types.Transaction, error) {
+	return _SchemaResolver.Contract.Revoke(&_SchemaResolver.TransactOpts, attestation)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_SchemaResolver *SchemaResolverTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SchemaResolver.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_SchemaResolver *SchemaResolverSession) Receive() (*types.Transaction, error) {
+	return _SchemaResolver.Contract.Receive(&_SchemaResolver.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_SchemaResolver *SchemaResolverTransactorSession) Receive() (*types.Transaction, error) {
+	return _SchemaResolver.Contract.Receive(&_SchemaResolver.TransactOpts)
+}
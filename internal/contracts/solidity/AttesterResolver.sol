@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity 0.8.21;
+
+import { Attestation, IEAS } from "./IEAS.sol";
+import { SchemaResolver } from "./SchemaResolver.sol";
+
+/// @title AttesterResolver
+/// @notice Schema resolver that accepts only attestations from the allowlist
+/// of attesters provided on deployment.
+contract AttesterResolver is SchemaResolver {
+    mapping(address attester => bool allowed) private _attesters;
+
+    constructor(IEAS eas, address[] memory attesters) SchemaResolver(eas) {
+        uint256 length = attesters.length;
+        for (uint256 i = 0; i < length; ++i) {
+            _attesters[attesters[i]] = true;
+        }
+    }
+
+    function isAllowed(address attester) external view returns (bool) {
+        return _attesters[attester];
+    }
+
+    function onAttest(Attestation calldata attestation, uint256 /* value */) internal view override returns (bool) {
+        return _attesters[attestation.attester];
+    }
+
+    function onRevoke(Attestation calldata /* attestation */, uint256 /* value */) internal pure override returns (bool) {
+        return true;
+    }
+}
@@ -0,0 +1,338 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity 0.8.21;
+
+import {
+    IEAS,
+    AttestationRequest,
+    AttestationRequestData,
+    EIP712Signature,
+    MultiAttestationRequest,
+    MultiRevocationRequest,
+    RevocationRequest,
+    RevocationRequestData
+} from "./IEAS.sol";
+
+struct DelegatedProxyAttestationRequest {
+    bytes32 schema;
+    AttestationRequestData data;
+    EIP712Signature signature;
+    address attester;
+    uint64 deadline;
+}
+
+struct MultiDelegatedProxyAttestationRequest {
+    bytes32 schema;
+    AttestationRequestData[] data;
+    EIP712Signature[] signatures;
+    address attester;
+    uint64 deadline;
+}
+
+struct DelegatedProxyRevocationRequest {
+    bytes32 schema;
+    RevocationRequestData data;
+    EIP712Signature signature;
+    address revoker;
+    uint64 deadline;
+}
+
+struct MultiDelegatedProxyRevocationRequest {
+    bytes32 schema;
+    RevocationRequestData[] data;
+    EIP712Signature[] signatures;
+    address revoker;
+    uint64 deadline;
+}
+
+/// @title EIP712Proxy
+/// @notice Proxy that submits attestations and revocations signed by
+/// attesters to EAS, following the upstream EAS EIP712Proxy 1.3.0 contract
+/// adapted to the EAS 1.0.0 interface.
+contract EIP712Proxy {
+    error AccessDenied();
+    error DeadlineExpired();
+    error InvalidEAS();
+    error InvalidLength();
+    error InvalidSignature();
+    error NotFound();
+    error UsedSignature();
+
+    uint64 private constant NO_EXPIRATION_TIME = 0;
+
+    bytes32 private constant DOMAIN_TYPEHASH =
+        keccak256("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)");
+
+    bytes32 private constant ATTEST_PROXY_TYPEHASH =
+        keccak256(
+            "Attest(address attester,bytes32 schema,address recipient,uint64 expirationTime,bool revocable,bytes32 refUID,bytes data,uint256 value,uint64 deadline)"
+        );
+
+    bytes32 private constant REVOKE_PROXY_TYPEHASH =
+        keccak256("Revoke(address revoker,bytes32 schema,bytes32 uid,uint256 value,uint64 deadline)");
+
+    string private constant VERSION = "1.3.0";
+
+    IEAS private immutable _eas;
+
+    string private _name;
+
+    bytes32 private immutable _cachedDomainSeparator;
+    uint256 private immutable _cachedChainId;
+
+    mapping(bytes32 uid => address attester) private _attesters;
+
+    mapping(bytes signature => bool used) private _signatures;
+
+    constructor(IEAS eas, string memory name) {
+        if (address(eas) == address(0)) {
+            revert InvalidEAS();
+        }
+
+        _eas = eas;
+        _name = name;
+        _cachedChainId = block.chainid;
+        _cachedDomainSeparator = _buildDomainSeparator();
+    }
+
+    function version() external pure returns (string memory) {
+        return VERSION;
+    }
+
+    function getEAS() external view returns (IEAS) {
+        return _eas;
+    }
+
+    function getDomainSeparator() external view returns (bytes32) {
+        return _domainSeparator();
+    }
+
+    function getAttestTypeHash() external pure returns (bytes32) {
+        return ATTEST_PROXY_TYPEHASH;
+    }
+
+    function getRevokeTypeHash() external pure returns (bytes32) {
+        return REVOKE_PROXY_TYPEHASH;
+    }
+
+    function getName() external view returns (string memory) {
+        return _name;
+    }
+
+    function getAttester(bytes32 uid) external view returns (address) {
+        return _attesters[uid];
+    }
+
+    function attestByDelegation(
+        DelegatedProxyAttestationRequest calldata delegatedRequest
+    ) public payable returns (bytes32) {
+        _verifyAttest(delegatedRequest);
+
+        bytes32 uid = _eas.attest{ value: msg.value }(
+            AttestationRequest({ schema: delegatedRequest.schema, data: delegatedRequest.data })
+        );
+
+        _attesters[uid] = delegatedRequest.attester;
+
+        return uid;
+    }
+
+    function multiAttestByDelegation(
+        MultiDelegatedProxyAttestationRequest[] calldata multiDelegatedRequests
+    ) public payable returns (bytes32[] memory) {
+        uint256 length = multiDelegatedRequests.length;
+        MultiAttestationRequest[] memory multiRequests = new MultiAttestationRequest[](length);
+
+        for (uint256 i = 0; i < length; ++i) {
+            MultiDelegatedProxyAttestationRequest calldata multiDelegatedRequest = multiDelegatedRequests[i];
+            AttestationRequestData[] calldata data = multiDelegatedRequest.data;
+
+            if (data.length == 0 || data.length != multiDelegatedRequest.signatures.length) {
+                revert InvalidLength();
+            }
+
+            for (uint256 j = 0; j < data.length; ++j) {
+                _verifyAttest(
+                    DelegatedProxyAttestationRequest({
+                        schema: multiDelegatedRequest.schema,
+                        data: data[j],
+                        signature: multiDelegatedRequest.signatures[j],
+                        attester: multiDelegatedRequest.attester,
+                        deadline: multiDelegatedRequest.deadline
+                    })
+                );
+            }
+
+            multiRequests[i] = MultiAttestationRequest({ schema: multiDelegatedRequest.schema, data: data });
+        }
+
+        bytes32[] memory uids = _eas.multiAttest{ value: msg.value }(multiRequests);
+
+        uint256 uidCounter = 0;
+        for (uint256 i = 0; i < length; ++i) {
+            MultiDelegatedProxyAttestationRequest calldata multiDelegatedRequest = multiDelegatedRequests[i];
+            for (uint256 j = 0; j < multiDelegatedRequest.data.length; ++j) {
+                _attesters[uids[uidCounter++]] = multiDelegatedRequest.attester;
+            }
+        }
+
+        return uids;
+    }
+
+    function revokeByDelegation(DelegatedProxyRevocationRequest calldata delegatedRequest) public payable {
+        _verifyRevoke(delegatedRequest);
+
+        _eas.revoke{ value: msg.value }(
+            RevocationRequest({ schema: delegatedRequest.schema, data: delegatedRequest.data })
+        );
+    }
+
+    function multiRevokeByDelegation(
+        MultiDelegatedProxyRevocationRequest[] calldata multiDelegatedRequests
+    ) public payable {
+        uint256 length = multiDelegatedRequests.length;
+        MultiRevocationRequest[] memory multiRequests = new MultiRevocationRequest[](length);
+
+        for (uint256 i = 0; i < length; ++i) {
+            MultiDelegatedProxyRevocationRequest calldata multiDelegatedRequest = multiDelegatedRequests[i];
+            RevocationRequestData[] calldata data = multiDelegatedRequest.data;
+
+            if (data.length == 0 || data.length != multiDelegatedRequest.signatures.length) {
+                revert InvalidLength();
+            }
+
+            for (uint256 j = 0; j < data.length; ++j) {
+                _verifyRevoke(
+                    DelegatedProxyRevocationRequest({
+                        schema: multiDelegatedRequest.schema,
+                        data: data[j],
+                        signature: multiDelegatedRequest.signatures[j],
+                        revoker: multiDelegatedRequest.revoker,
+                        deadline: multiDelegatedRequest.deadline
+                    })
+                );
+            }
+
+            multiRequests[i] = MultiRevocationRequest({ schema: multiDelegatedRequest.schema, data: data });
+        }
+
+        _eas.multiRevoke{ value: msg.value }(multiRequests);
+    }
+
+    function _verifyAttest(DelegatedProxyAttestationRequest memory request) internal {
+        if (request.deadline != NO_EXPIRATION_TIME && request.deadline < block.timestamp) {
+            revert DeadlineExpired();
+        }
+
+        AttestationRequestData memory data = request.data;
+        EIP712Signature memory signature = request.signature;
+
+        _verifyUnusedSignature(signature);
+
+        bytes32 digest = _hashTypedData(
+            keccak256(
+                abi.encode(
+                    ATTEST_PROXY_TYPEHASH,
+                    request.attester,
+                    request.schema,
+                    data.recipient,
+                    data.expirationTime,
+                    data.revocable,
+                    data.refUID,
+                    keccak256(data.data),
+                    data.value,
+                    request.deadline
+                )
+            )
+        );
+
+        if (_recover(digest, signature) != request.attester) {
+            revert InvalidSignature();
+        }
+    }
+
+    function _verifyRevoke(DelegatedProxyRevocationRequest memory request) internal {
+        if (request.deadline != NO_EXPIRATION_TIME && request.deadline < block.timestamp) {
+            revert DeadlineExpired();
+        }
+
+        RevocationRequestData memory data = request.data;
+
+        address attester = _attesters[data.uid];
+        if (attester == address(0)) {
+            revert NotFound();
+        }
+        if (attester != request.revoker) {
+            revert AccessDenied();
+        }
+
+        EIP712Signature memory signature = request.signature;
+
+        _verifyUnusedSignature(signature);
+
+        bytes32 digest = _hashTypedData(
+            keccak256(
+                abi.encode(
+                    REVOKE_PROXY_TYPEHASH,
+                    request.revoker,
+                    request.schema,
+                    data.uid,
+                    data.value,
+                    request.deadline
+                )
+            )
+        );
+
+        if (_recover(digest, signature) != request.revoker) {
+            revert InvalidSignature();
+        }
+    }
+
+    function _verifyUnusedSignature(EIP712Signature memory signature) internal {
+        bytes memory packedSignature = abi.encodePacked(signature.r, signature.s, signature.v);
+
+        if (_signatures[packedSignature]) {
+            revert UsedSignature();
+        }
+
+        _signatures[packedSignature] = true;
+    }
+
+    function _domainSeparator() internal view returns (bytes32) {
+        if (block.chainid == _cachedChainId) {
+            return _cachedDomainSeparator;
+        }
+        return _buildDomainSeparator();
+    }
+
+    function _buildDomainSeparator() internal view returns (bytes32) {
+        return
+            keccak256(
+                abi.encode(
+                    DOMAIN_TYPEHASH,
+                    keccak256(bytes(_name)),
+                    keccak256(bytes(VERSION)),
+                    block.chainid,
+                    address(this)
+                )
+            );
+    }
+
+    function _hashTypedData(bytes32 structHash) internal view returns (bytes32) {
+        return keccak256(abi.encodePacked("\x19\x01", _domainSeparator(), structHash));
+    }
+
+    function _recover(bytes32 digest, EIP712Signature memory signature) internal pure returns (address) {
+        // reject malleable signatures as in OpenZeppelin ECDSA
+        if (uint256(signature.s) > 0x7FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF5D576E7357A4501DDFE92F46681B20A0) {
+            revert InvalidSignature();
+        }
+
+        address signer = ecrecover(digest, signature.v, signature.r, signature.s);
+        if (signer == address(0)) {
+            revert InvalidSignature();
+        }
+
+        return signer;
+    }
+}
@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity 0.8.21;
+
+import { Attestation, IEAS } from "./IEAS.sol";
+import { SchemaResolver } from "./SchemaResolver.sol";
+
+/// @title ExpirationTimeResolver
+/// @notice Schema resolver that accepts only attestations that expire at or
+/// after the time provided on deployment.
+contract ExpirationTimeResolver is SchemaResolver {
+    uint256 private immutable _validAfter;
+
+    constructor(IEAS eas, uint256 validAfter) SchemaResolver(eas) {
+        _validAfter = validAfter;
+    }
+
+    function getValidAfter() external view returns (uint256) {
+        return _validAfter;
+    }
+
+    function onAttest(Attestation calldata attestation, uint256 /* value */) internal view override returns (bool) {
+        return attestation.expirationTime >= _validAfter;
+    }
+
+    function onRevoke(Attestation calldata /* attestation */, uint256 /* value */) internal pure override returns (bool) {
+        return true;
+    }
+}
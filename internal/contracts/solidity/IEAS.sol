@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity 0.8.21;
+
+// Subset of the EAS 1.0.0 interface used by the EIP712Proxy and Indexer
+// contracts.
+
+struct EIP712Signature {
+    uint8 v;
+    bytes32 r;
+    bytes32 s;
+}
+
+struct Attestation {
+    bytes32 uid;
+    bytes32 schema;
+    uint64 time;
+    uint64 expirationTime;
+    uint64 revocationTime;
+    bytes32 refUID;
+    address recipient;
+    address attester;
+    bool revocable;
+    bytes data;
+}
+
+struct AttestationRequestData {
+    address recipient;
+    uint64 expirationTime;
+    bool revocable;
+    bytes32 refUID;
+    bytes data;
+    uint256 value;
+}
+
+struct AttestationRequest {
+    bytes32 schema;
+    AttestationRequestData data;
+}
+
+struct MultiAttestationRequest {
+    bytes32 schema;
+    AttestationRequestData[] data;
+}
+
+struct RevocationRequestData {
+    bytes32 uid;
+    uint256 value;
+}
+
+struct RevocationRequest {
+    bytes32 schema;
+    RevocationRequestData data;
+}
+
+struct MultiRevocationRequest {
+    bytes32 schema;
+    RevocationRequestData[] data;
+}
+
+interface IEAS {
+    function attest(AttestationRequest calldata request) external payable returns (bytes32);
+
+    function multiAttest(MultiAttestationRequest[] calldata multiRequests) external payable returns (bytes32[] memory);
+
+    function revoke(RevocationRequest calldata request) external payable;
+
+    function multiRevoke(MultiRevocationRequest[] calldata multiRequests) external payable;
+
+    function getAttestation(bytes32 uid) external view returns (Attestation memory);
+}
@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity 0.8.21;
+
+import { IEAS, Attestation } from "./IEAS.sol";
+
+/// @title Indexer
+/// @notice Indexing service for EAS attestations, following the upstream EAS
+/// Indexer 1.3.0 contract adapted to the EAS 1.0.0 interface.
+contract Indexer {
+    error InvalidEAS();
+    error InvalidAttestation();
+    error InvalidOffset();
+
+    /// @notice Emitted when an attestation has been indexed.
+    event Indexed(bytes32 indexed uid);
+
+    string private constant VERSION = "1.3.0";
+
+    IEAS private immutable _eas;
+
+    // recipient => schema => uids
+    mapping(address recipient => mapping(bytes32 schema => bytes32[] uids)) private _receivedAttestations;
+
+    // attester => schema => uids
+    mapping(address attester => mapping(bytes32 schema => bytes32[] uids)) private _sentAttestations;
+
+    // schema => attester => recipient => uids
+    mapping(bytes32 schema => mapping(address attester => mapping(address recipient => bytes32[] uids)))
+        private _schemaAttesterRecipientAttestations;
+
+    // schema => uids
+    mapping(bytes32 schema => bytes32[] uids) private _schemaAttestations;
+
+    mapping(bytes32 uid => bool isIndexed) private _indexedAttestations;
+
+    constructor(IEAS eas) {
+        if (address(eas) == address(0)) {
+            revert InvalidEAS();
+        }
+
+        _eas = eas;
+    }
+
+    function version() external pure returns (string memory) {
+        return VERSION;
+    }
+
+    function getEAS() external view returns (IEAS) {
+        return _eas;
+    }
+
+    function indexAttestation(bytes32 attestationUID) external {
+        _indexAttestation(attestationUID);
+    }
+
+    function indexAttestations(bytes32[] calldata attestationUIDs) external {
+        uint256 length = attestationUIDs.length;
+        for (uint256 i = 0; i < length; ++i) {
+            _indexAttestation(attestationUIDs[i]);
+        }
+    }
+
+    function isAttestationIndexed(bytes32 attestationUID) external view returns (bool) {
+        return _indexedAttestations[attestationUID];
+    }
+
+    function getReceivedAttestationUIDs(
+        address recipient,
+        bytes32 schemaUID,
+        uint256 start,
+        uint256 length,
+        bool reverseOrder
+    ) external view returns (bytes32[] memory) {
+        return _sliceUIDs(_receivedAttestations[recipient][schemaUID], start, length, reverseOrder);
+    }
+
+    function getReceivedAttestationUIDCount(address recipient, bytes32 schemaUID) external view returns (uint256) {
+        return _receivedAttestations[recipient][schemaUID].length;
+    }
+
+    function getSentAttestationUIDs(
+        address attester,
+        bytes32 schemaUID,
+        uint256 start,
+        uint256 length,
+        bool reverseOrder
+    ) external view returns (bytes32[] memory) {
+        return _sliceUIDs(_sentAttestations[attester][schemaUID], start, length, reverseOrder);
+    }
+
+    function getSentAttestationUIDCount(address attester, bytes32 schemaUID) external view returns (uint256) {
+        return _sentAttestations[attester][schemaUID].length;
+    }
+
+    function getSchemaAttesterRecipientAttestationUIDs(
+        bytes32 schemaUID,
+        address attester,
+        address recipient,
+        uint256 start,
+        uint256 length,
+        bool reverseOrder
+    ) external view returns (bytes32[] memory) {
+        return
+            _sliceUIDs(
+                _schemaAttesterRecipientAttestations[schemaUID][attester][recipient],
+                start,
+                length,
+                reverseOrder
+            );
+    }
+
+    function getSchemaAttesterRecipientAttestationUIDCount(
+        bytes32 schemaUID,
+        address attester,
+        address recipient
+    ) external view returns (uint256) {
+        return _schemaAttesterRecipientAttestations[schemaUID][attester][recipient].length;
+    }
+
+    function getSchemaAttestationUIDs(
+        bytes32 schemaUID,
+        uint256 start,
+        uint256 length,
+        bool reverseOrder
+    ) external view returns (bytes32[] memory) {
+        return _sliceUIDs(_schemaAttestations[schemaUID], start, length, reverseOrder);
+    }
+
+    function getSchemaAttestationUIDCount(bytes32 schemaUID) external view returns (uint256) {
+        return _schemaAttestations[schemaUID].length;
+    }
+
+    function _indexAttestation(bytes32 attestationUID) private {
+        // skip already indexed attestations
+        if (_indexedAttestations[attestationUID]) {
+            return;
+        }
+
+        Attestation memory attestation = _eas.getAttestation(attestationUID);
+        if (attestation.uid == bytes32(0)) {
+            revert InvalidAttestation();
+        }
+
+        _receivedAttestations[attestation.recipient][attestation.schema].push(attestationUID);
+        _sentAttestations[attestation.attester][attestation.schema].push(attestationUID);
+        _schemaAttesterRecipientAttestations[attestation.schema][attestation.attester][attestation.recipient].push(
+            attestationUID
+        );
+        _schemaAttestations[attestation.schema].push(attestationUID);
+
+        _indexedAttestations[attestationUID] = true;
+
+        emit Indexed(attestationUID);
+    }
+
+    function _sliceUIDs(
+        bytes32[] memory uids,
+        uint256 start,
+        uint256 length,
+        bool reverseOrder
+    ) private pure returns (bytes32[] memory) {
+        uint256 attestationsLength = uids.length;
+        if (attestationsLength == 0) {
+            return new bytes32[](0);
+        }
+
+        if (start >= attestationsLength) {
+            revert InvalidOffset();
+        }
+
+        uint256 len = length;
+        if (attestationsLength < start + length) {
+            len = attestationsLength - start;
+        }
+
+        bytes32[] memory res = new bytes32[](len);
+
+        for (uint256 i = 0; i < len; ++i) {
+            res[i] = uids[reverseOrder ? attestationsLength - (start + i + 1) : start + i];
+        }
+
+        return res;
+    }
+}
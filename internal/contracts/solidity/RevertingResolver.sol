@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity 0.8.21;
+
+import { Attestation } from "./IEAS.sol";
+
+/// @title RevertingResolver
+/// @notice Schema resolver used in tests that reverts in different ways
+/// depending on the first byte of attestation data: a custom error for 0, an
+/// error message for 1 and a panic for 2. Attestations with empty data are
+/// accepted.
+contract RevertingResolver {
+    error Rejected(bytes32 uid, string reason);
+
+    function isPayable() external pure returns (bool) {
+        return false;
+    }
+
+    function attest(Attestation calldata attestation) external payable returns (bool) {
+        _check(attestation);
+        return true;
+    }
+
+    function multiAttest(Attestation[] calldata attestations, uint256[] calldata) external payable returns (bool) {
+        for (uint256 i = 0; i < attestations.length; ++i) {
+            _check(attestations[i]);
+        }
+        return true;
+    }
+
+    function revoke(Attestation calldata attestation) external payable returns (bool) {
+        revert Rejected(attestation.uid, "irrevocable");
+    }
+
+    function multiRevoke(Attestation[] calldata attestations, uint256[] calldata) external payable returns (bool) {
+        revert Rejected(attestations[0].uid, "irrevocable");
+    }
+
+    function _check(Attestation calldata attestation) private pure {
+        if (attestation.data.length == 0) {
+            return;
+        }
+
+        uint8 mode = uint8(attestation.data[0]);
+        if (mode == 0) {
+            revert Rejected(attestation.uid, "rejected");
+        }
+        require(mode != 1, "rejected with message");
+        assert(mode != 2);
+    }
+}
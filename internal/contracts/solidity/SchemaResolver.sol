@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity 0.8.21;
+
+import { Attestation, IEAS } from "./IEAS.sol";
+
+/// @title ISchemaResolver
+/// @notice The interface of an optional schema resolver that EAS 1.0.0 calls
+/// on every attestation and revocation of schemas that use it.
+interface ISchemaResolver {
+    function isPayable() external pure returns (bool);
+
+    function attest(Attestation calldata attestation) external payable returns (bool);
+
+    function multiAttest(
+        Attestation[] calldata attestations,
+        uint256[] calldata values
+    ) external payable returns (bool);
+
+    function revoke(Attestation calldata attestation) external payable returns (bool);
+
+    function multiRevoke(
+        Attestation[] calldata attestations,
+        uint256[] calldata values
+    ) external payable returns (bool);
+}
+
+/// @title SchemaResolver
+/// @notice The base schema resolver contract, following the upstream EAS
+/// 1.0.0 SchemaResolver contract.
+abstract contract SchemaResolver is ISchemaResolver {
+    error AccessDenied();
+    error InsufficientValue();
+    error InvalidEAS();
+    error InvalidLength();
+    error NotPayable();
+
+    string private constant VERSION = "1.0.0";
+
+    IEAS internal immutable _eas;
+
+    constructor(IEAS eas) {
+        if (address(eas) == address(0)) {
+            revert InvalidEAS();
+        }
+
+        _eas = eas;
+    }
+
+    modifier onlyEAS() {
+        if (msg.sender != address(_eas)) {
+            revert AccessDenied();
+        }
+
+        _;
+    }
+
+    function version() external pure returns (string memory) {
+        return VERSION;
+    }
+
+    function isPayable() public pure virtual returns (bool) {
+        return false;
+    }
+
+    receive() external payable virtual {
+        if (!isPayable()) {
+            revert NotPayable();
+        }
+    }
+
+    function attest(Attestation calldata attestation) external payable onlyEAS returns (bool) {
+        return onAttest(attestation, msg.value);
+    }
+
+    function multiAttest(
+        Attestation[] calldata attestations,
+        uint256[] calldata values
+    ) external payable onlyEAS returns (bool) {
+        uint256 length = attestations.length;
+        if (length != values.length) {
+            revert InvalidLength();
+        }
+
+        uint256 remainingValue = msg.value;
+
+        for (uint256 i = 0; i < length; ++i) {
+            uint256 value = values[i];
+            if (value > remainingValue) {
+                revert InsufficientValue();
+            }
+
+            if (!onAttest(attestations[i], value)) {
+                return false;
+            }
+
+            remainingValue -= value;
+        }
+
+        return true;
+    }
+
+    function revoke(Attestation calldata attestation) external payable onlyEAS returns (bool) {
+        return onRevoke(attestation, msg.value);
+    }
+
+    function multiRevoke(
+        Attestation[] calldata attestations,
+        uint256[] calldata values
+    ) external payable onlyEAS returns (bool) {
+        uint256 length = attestations.length;
+        if (length != values.length) {
+            revert InvalidLength();
+        }
+
+        uint256 remainingValue = msg.value;
+
+        for (uint256 i = 0; i < length; ++i) {
+            uint256 value = values[i];
+            if (value > remainingValue) {
+                revert InsufficientValue();
+            }
+
+            if (!onRevoke(attestations[i], value)) {
+                return false;
+            }
+
+            remainingValue -= value;
+        }
+
+        return true;
+    }
+
+    function onAttest(Attestation calldata attestation, uint256 value) internal virtual returns (bool);
+
+    function onRevoke(Attestation calldata attestation, uint256 value) internal virtual returns (bool);
+}
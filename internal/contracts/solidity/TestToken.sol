@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity 0.8.21;
+
+/// @title TestToken
+/// @notice Minimal ERC20 token used in tests of token gated schema resolvers,
+/// where the deployer can mint tokens to any account.
+contract TestToken {
+    error AccessDenied();
+    error InsufficientBalance();
+
+    event Transfer(address indexed from, address indexed to, uint256 value);
+
+    address private immutable _owner;
+
+    uint256 public totalSupply;
+
+    mapping(address account => uint256 balance) public balanceOf;
+
+    constructor() {
+        _owner = msg.sender;
+    }
+
+    function mint(address to, uint256 value) external {
+        if (msg.sender != _owner) {
+            revert AccessDenied();
+        }
+
+        totalSupply += value;
+        balanceOf[to] += value;
+
+        emit Transfer(address(0), to, value);
+    }
+
+    function transfer(address to, uint256 value) external returns (bool) {
+        if (balanceOf[msg.sender] < value) {
+            revert InsufficientBalance();
+        }
+
+        balanceOf[msg.sender] -= value;
+        balanceOf[to] += value;
+
+        emit Transfer(msg.sender, to, value);
+
+        return true;
+    }
+}
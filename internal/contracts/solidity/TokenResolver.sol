@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity 0.8.21;
+
+import { Attestation, IEAS } from "./IEAS.sol";
+import { SchemaResolver } from "./SchemaResolver.sol";
+
+interface IERC20Balance {
+    function balanceOf(address account) external view returns (uint256);
+}
+
+/// @title TokenResolver
+/// @notice Schema resolver that accepts only attestations from attesters that
+/// hold at least the minimal balance of the ERC20 token provided on
+/// deployment.
+contract TokenResolver is SchemaResolver {
+    IERC20Balance private immutable _token;
+    uint256 private immutable _minimalBalance;
+
+    constructor(IEAS eas, IERC20Balance token, uint256 minimalBalance) SchemaResolver(eas) {
+        _token = token;
+        _minimalBalance = minimalBalance;
+    }
+
+    function getToken() external view returns (IERC20Balance) {
+        return _token;
+    }
+
+    function getMinimalBalance() external view returns (uint256) {
+        return _minimalBalance;
+    }
+
+    function onAttest(Attestation calldata attestation, uint256 /* value */) internal view override returns (bool) {
+        return _token.balanceOf(attestation.attester) >= _minimalBalance;
+    }
+
+    function onRevoke(Attestation calldata /* attestation */, uint256 /* value */) internal pure override returns (bool) {
+        return true;
+    }
+}
@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity 0.8.21;
+
+import { Attestation, IEAS } from "./IEAS.sol";
+import { SchemaResolver } from "./SchemaResolver.sol";
+
+/// @title ValueResolver
+/// @notice Payable schema resolver that accepts only attestations that send
+/// exactly the value provided on deployment to the resolver.
+contract ValueResolver is SchemaResolver {
+    uint256 private immutable _targetValue;
+
+    constructor(IEAS eas, uint256 targetValue) SchemaResolver(eas) {
+        _targetValue = targetValue;
+    }
+
+    function getTargetValue() external view returns (uint256) {
+        return _targetValue;
+    }
+
+    function isPayable() public pure override returns (bool) {
+        return true;
+    }
+
+    function onAttest(Attestation calldata /* attestation */, uint256 value) internal view override returns (bool) {
+        return value == _targetValue;
+    }
+
+    function onRevoke(Attestation calldata /* attestation */, uint256 /* value */) internal pure override returns (bool) {
+        return true;
+    }
+}
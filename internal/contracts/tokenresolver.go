@@ -0,0 +1,432 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// TokenResolverMetaData contains all meta data concerning the TokenResolver contract.
+var TokenResolverMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"contractIEAS\",\"name\":\"eas\",\"type\":\"address\"},{\"internalType\":\"contractIERC20Balance\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"minimalBalance\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"AccessDenied\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InsufficientValue\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidEAS\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidLength\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotPayable\",\"type\":\"error\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"schema\",\"type\":\"bytes32\"},{\"internalType\":\"uint64\",\"name\":\"time\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"expirationTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"revocationTime\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"refUID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"revocable\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structAttestation\",\"name\":\"attestation\",\"type\":\"tuple\"}],\"name\":\"attest\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinimalBalance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getToken\",\"outputs\":[{\"internalType\":\"contractIERC20Balance\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isPayable\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"schema\",\"type\":\"bytes32\"},{\"internalType\":\"uint64\",\"name\":\"time\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"expirationTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"revocationTime\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"refUID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"revocable\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structAttestation[]\",\"name\":\"attestations\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256[]\",\"name\":\"values\",\"type\":\"uint256[]\"}],\"name\":\"multiAttest\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"schema\",\"type\":\"bytes32\"},{\"internalType\":\"uint64\",\"name\":\"time\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"expirationTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"revocationTime\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"refUID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"revocable\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structAttestation[]\",\"name\":\"attestations\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256[]\",\"name\":\"values\",\"type\":\"uint256[]\"}],\"name\":\"multiRevoke\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"schema\",\"type\":\"bytes32\"},{\"internalType\":\"uint64\",\"name\":\"time\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"expirationTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"revocationTime\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"refUID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"revocable\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structAttestation\",\"name\":\"attestation\",\"type\":\"tuple\"}],\"name\":\"revoke\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"version\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
+	Bin: "0x60e060405234801561001057600080fd5b506040516108d23803806108d283398101604081905261002f9161008d565b826001600160a01b038116610057576040516341bc07ff60e11b815260040160405180910390fd5b6001600160a01b039081166080529190911660a05260c052506100d0565b6001600160a01b038116811461008a57600080fd5b50565b6000806000606084860312156100a257600080fd5b83516100ad81610075565b60208501519093506100be81610075565b80925050604084015190509250925092565b60805160a05160c0516107b1610121600039600081816101a101526104a701526000818160bc01526104d00152600081816101d7015281816102f90152818161040b015261045f01526107b16000f3fe60806040526004361061007f5760003560e01c8063ce46e0461161004e578063ce46e04614610155578063e49617e114610169578063e60c35051461017c578063f1814c8c1461018f57600080fd5b806321df0da7146100a257806354fd4d50146100eb57806388e5b2d91461011f57806391db0b7e1461014257600080fd5b3661009d57604051631574f9f360e01b815260040160405180910390fd5b600080fd5b3480156100ae57600080fd5b506040516001600160a01b037f00000000000000000000000000000000000000000000000000000000000000001681526020015b60405180910390f35b3480156100f757600080fd5b5060408051808201825260058152640312e302e360dc1b602082015290516100e29190610577565b61013261012d366004610611565b6101ca565b60405190151581526020016100e2565b610132610150366004610611565b6102ec565b34801561016157600080fd5b506000610132565b61013261017736600461067d565b6103fe565b61013261018a36600461067d565b610452565b34801561019b57600080fd5b506040517f000000000000000000000000000000000000000000000000000000000000000081526020016100e2565b6000336001600160a01b037f0000000000000000000000000000000000000000000000000000000000000000161461021557604051634ca8886760e01b815260040160405180910390fd5b838281146102365760405163251f56a160e21b815260040160405180910390fd5b3460005b828110156102dc576000868683818110610256576102566106c0565b905060200201359050828111156102805760405163044044a560e21b815260040160405180910390fd5b6102ad898984818110610295576102956106c0565b90506020028101906102a791906106d6565b50600190565b6102be5760009450505050506102e4565b6102c8818461070d565b925050806102d590610720565b905061023a565b506001925050505b949350505050565b6000336001600160a01b037f0000000000000000000000000000000000000000000000000000000000000000161461033757604051634ca8886760e01b815260040160405180910390fd5b838281146103585760405163251f56a160e21b815260040160405180910390fd5b3460005b828110156102dc576000868683818110610378576103786106c0565b905060200201359050828111156103a25760405163044044a560e21b815260040160405180910390fd5b6103cf8989848181106103b7576103b76106c0565b90506020028101906103c991906106d6565b826104a3565b6103e05760009450505050506102e4565b6103ea818461070d565b925050806103f790610720565b905061035c565b6000336001600160a01b037f0000000000000000000000000000000000000000000000000000000000000000161461044957604051634ca8886760e01b815260040160405180910390fd5b60015b92915050565b6000336001600160a01b037f0000000000000000000000000000000000000000000000000000000000000000161461049d57604051634ca8886760e01b815260040160405180910390fd5b61044c82345b60007f00000000000000000000000000000000000000000000000000000000000000006001600160a01b037f0000000000000000000000000000000000000000000000000000000000000000166370a08231610506610100870160e08801610739565b6040516001600160e01b031960e084901b1681526001600160a01b039091166004820152602401602060405180830381865afa15801561054a573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061056e9190610762565b10159392505050565b600060208083528351808285015260005b818110156105a457858101830151858201604001528201610588565b506000604082860101526040601f19601f8301168501019250505092915050565b60008083601f8401126105d757600080fd5b50813567ffffffffffffffff8111156105ef57600080fd5b6020830191508360208260051b850101111561060a57600080fd5b9250929050565b6000806000806040858703121561062757600080fd5b843567ffffffffffffffff8082111561063f57600080fd5b61064b888389016105c5565b9096509450602087013591508082111561066457600080fd5b50610671878288016105c5565b95989497509550505050565b60006020828403121561068f57600080fd5b813567ffffffffffffffff8111156106a657600080fd5b820161014081850312156106b957600080fd5b9392505050565b634e487b7160e01b600052603260045260246000fd5b6000823561013e198336030181126106ed57600080fd5b9190910192915050565b634e487b7160e01b600052601160045260246000fd5b8181038181111561044c5761044c6106f7565b600060018201610732576107326106f7565b5060010190565b60006020828403121561074b57600080fd5b81356001600160a01b03811681146106b957600080fd5b60006020828403121561077457600080fd5b505191905056fea2646970667358221220201236f981ef54ee8edecd62ed2af87c2f940751ecbba81f03a22f435e620c0464736f6c63430008150033",
+}
+
+// TokenResolverABI is the input ABI used to generate the binding from.
+// Deprecated: Use TokenResolverMetaData.ABI instead.
+var TokenResolverABI = TokenResolverMetaData.ABI
+
+// TokenResolverBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use TokenResolverMetaData.Bin instead.
+var TokenResolverBin = TokenResolverMetaData.Bin
+
+// DeployTokenResolver deploys a new Ethereum contract, binding an instance of TokenResolver to it.
+func DeployTokenResolver(auth *bind.TransactOpts, backend bind.ContractBackend, eas common.Address, token common.Address, minimalBalance *big.Int) (common.Address, *types.Transaction, *TokenResolver, error) {
+	parsed, err := TokenResolverMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(TokenResolverBin), backend, eas, token, minimalBalance)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &TokenResolver{TokenResolverCaller: TokenResolverCaller{contract: contract}, TokenResolverTransactor: TokenResolverTransactor{contract: contract}, TokenResolverFilterer: TokenResolverFilterer{contract: contract}}, nil
+}
+
+// TokenResolver is an auto generated Go binding around an Ethereum contract.
+type TokenResolver struct {
+	TokenResolverCaller     // Read-only binding to the contract
+	TokenResolverTransactor // Write-only binding to the contract
+	TokenResolverFilterer   // Log filterer for contract events
+}
+
+// TokenResolverCaller is an auto generated read-only Go binding around an Ethereum contract.
+type TokenResolverCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TokenResolverTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type TokenResolverTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TokenResolverFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type TokenResolverFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TokenResolverSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type TokenResolverSession struct {
+	Contract     *TokenResolver    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// TokenResolverCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type TokenResolverCallerSession struct {
+	Contract *TokenResolverCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// TokenResolverTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type TokenResolverTransactorSession struct {
+	Contract     *TokenResolverTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// TokenResolverRaw is an auto generated low-level Go binding around an Ethereum contract.
+type TokenResolverRaw struct {
+	Contract *TokenResolver // Generic contract binding to access the raw methods on
+}
+
+// TokenResolverCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type TokenResolverCallerRaw struct {
+	Contract *TokenResolverCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// TokenResolverTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type TokenResolverTransactorRaw struct {
+	Contract *TokenResolverTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewTokenResolver creates a new instance of TokenResolver, bound to a specific deployed contract.
+func NewTokenResolver(address common.Address, backend bind.ContractBackend) (*TokenResolver, error) {
+	contract, err := bindTokenResolver(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenResolver{TokenResolverCaller: TokenResolverCaller{contract: contract}, TokenResolverTransactor: TokenResolverTransactor{contract: contract}, TokenResolverFilterer: TokenResolverFilterer{contract: contract}}, nil
+}
+
+// NewTokenResolverCaller creates a new read-only instance of TokenResolver, bound to a specific deployed contract.
+func NewTokenResolverCaller(address common.Address, caller bind.ContractCaller) (*TokenResolverCaller, error) {
+	contract, err := bindTokenResolver(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenResolverCaller{contract: contract}, nil
+}
+
+// NewTokenResolverTransactor creates a new write-only instance of TokenResolver, bound to a specific deployed contract.
+func NewTokenResolverTransactor(address common.Address, transactor bind.ContractTransactor) (*TokenResolverTransactor, error) {
+	contract, err := bindTokenResolver(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenResolverTransactor{contract: contract}, nil
+}
+
+// NewTokenResolverFilterer creates a new log filterer instance of TokenResolver, bound to a specific deployed contract.
+func NewTokenResolverFilterer(address common.Address, filterer bind.ContractFilterer) (*TokenResolverFilterer, error) {
+	contract, err := bindTokenResolver(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenResolverFilterer{contract: contract}, nil
+}
+
+// bindTokenResolver binds a generic wrapper to an already deployed contract.
+func bindTokenResolver(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := TokenResolverMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_TokenResolver *TokenResolverRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _TokenResolver.Contract.TokenResolverCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_TokenResolver *TokenResolverRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _TokenResolver.Contract.TokenResolverTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_TokenResolver *TokenResolverRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _TokenResolver.Contract.TokenResolverTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_TokenResolver *TokenResolverCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _TokenResolver.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_TokenResolver *TokenResolverTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _TokenResolver.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_TokenResolver *TokenResolverTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _TokenResolver.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetMinimalBalance is a free data retrieval call binding the contract method 0xf1814c8c.
+//
+// Solidity: function getMinimalBalance() view returns(uint256)
+func (_TokenResolver *TokenResolverCaller) GetMinimalBalance(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _TokenResolver.contract.Call(opts, &out, "getMinimalBalance")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetMinimalBalance is a free data retrieval call binding the contract method 0xf1814c8c.
+//
+// Solidity: function getMinimalBalance() view returns(uint256)
+func (_TokenResolver *TokenResolverSession) GetMinimalBalance() (*big.Int, error) {
+	return _TokenResolver.Contract.GetMinimalBalance(&_TokenResolver.CallOpts)
+}
+
+// GetMinimalBalance is a free data retrieval call binding the contract method 0xf1814c8c.
+//
+// Solidity: function getMinimalBalance() view returns(uint256)
+func (_TokenResolver *TokenResolverCallerSession) GetMinimalBalance() (*big.Int, error) {
+	return _TokenResolver.Contract.GetMinimalBalance(&_TokenResolver.CallOpts)
+}
+
+// GetToken is a free data retrieval call binding the contract method 0x21df0da7.
+//
+// Solidity: function getToken() view returns(address)
+func (_TokenResolver *TokenResolverCaller) GetToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _TokenResolver.contract.Call(opts, &out, "getToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetToken is a free data retrieval call binding the contract method 0x21df0da7.
+//
+// Solidity: function getToken() view returns(address)
+func (_TokenResolver *TokenResolverSession) GetToken() (common.Address, error) {
+	return _TokenResolver.Contract.GetToken(&_TokenResolver.CallOpts)
+}
+
+// GetToken is a free data retrieval call binding the contract method 0x21df0da7.
+//
+// Solidity: function getToken() view returns(address)
+func (_TokenResolver *TokenResolverCallerSession) GetToken() (common.Address, error) {
+	return _TokenResolver.Contract.GetToken(&_TokenResolver.CallOpts)
+}
+
+// IsPayable is a free data retrieval call binding the contract method 0xce46e046.
+//
+// Solidity: function isPayable() pure returns(bool)
+func (_TokenResolver *TokenResolverCaller) IsPayable(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _TokenResolver.contract.Call(opts, &out, "isPayable")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsPayable is a free data retrieval call binding the contract method 0xce46e046.
+//
+// Solidity: function isPayable() pure returns(bool)
+func (_TokenResolver *TokenResolverSession) IsPayable() (bool, error) {
+	return _TokenResolver.Contract.IsPayable(&_TokenResolver.CallOpts)
+}
+
+// IsPayable is a free data retrieval call binding the contract method 0xce46e046.
+//
+// Solidity: function isPayable() pure returns(bool)
+func (_TokenResolver *TokenResolverCallerSession) IsPayable() (bool, error) {
+	return _TokenResolver.Contract.IsPayable(&_TokenResolver.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(string)
+func (_TokenResolver *TokenResolverCaller) Version(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _TokenResolver.contract.Call(opts, &out, "version")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(string)
+func (_TokenResolver *TokenResolverSession) Version() (string, error) {
+	return _TokenResolver.Contract.Version(&_TokenResolver.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(string)
+func (_TokenResolver *TokenResolverCallerSession) Version() (string, error) {
+	return _TokenResolver.Contract.Version(&_TokenResolver.CallOpts)
+}
+
+// Attest is a paid mutator transaction binding the contract method 0xe60c3505.
+//
+// Solidity: function attest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_TokenResolver *TokenResolverTransactor) Attest(opts *bind.TransactOpts, attestation Attestation) (*types.Transaction, error) {
+	return _TokenResolver.contract.Transact(opts, "attest", attestation)
+}
+
+// Attest is a paid mutator transaction binding the contract method 0xe60c3505.
+//
+// Solidity: function attest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_TokenResolver *TokenResolverSession) Attest(attestation Attestation) (*types.Transaction, error) {
+	return _TokenResolver.Contract.Attest(&_TokenResolver.TransactOpts, attestation)
+}
+
+// Attest is a paid mutator transaction binding the contract method 0xe60c3505.
+//
+// Solidity: function attest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_TokenResolver *TokenResolverTransactorSession) Attest(attestation Attestation) (*types.Transaction, error) {
+	return _TokenResolver.Contract.Attest(&_TokenResolver.TransactOpts, attestation)
+}
+
+// MultiAttest is a paid mutator transaction binding the contract method 0x91db0b7e.
+//
+// Solidity: function multiAttest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_TokenResolver *TokenResolverTransactor) MultiAttest(opts *bind.TransactOpts, attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _TokenResolver.contract.Transact(opts, "multiAttest", attestations, values)
+}
+
+// MultiAttest is a paid mutator transaction binding the contract method 0x91db0b7e.
+//
+// Solidity: function multiAttest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_TokenResolver *TokenResolverSession) MultiAttest(attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _TokenResolver.Contract.MultiAttest(&_TokenResolver.TransactOpts, attestations, values)
+}
+
+// MultiAttest is a paid mutator transaction binding the contract method 0x91db0b7e.
+//
+// Solidity: function multiAttest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_TokenResolver *TokenResolverTransactorSession) MultiAttest(attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _TokenResolver.Contract.MultiAttest(&_TokenResolver.TransactOpts, attestations, values)
+}
+
+// MultiRevoke is a paid mutator transaction binding the contract method 0x88e5b2d9.
+//
+// Solidity: function multiRevoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_TokenResolver *TokenResolverTransactor) MultiRevoke(opts *bind.TransactOpts, attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _TokenResolver.contract.Transact(opts, "multiRevoke", attestations, values)
+}
+
+// MultiRevoke is a paid mutator transaction binding the contract method 0x88e5b2d9.
+//
+// Solidity: function multiRevoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_TokenResolver *TokenResolverSession) MultiRevoke(attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _TokenResolver.Contract.MultiRevoke(&_TokenResolver.TransactOpts, attestations, values)
+}
+
+// MultiRevoke is a paid mutator transaction binding the contract method 0x88e5b2d9.
+//
+// Solidity: function multiRevoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_TokenResolver *TokenResolverTransactorSession) MultiRevoke(attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _TokenResolver.Contract.MultiRevoke(&_TokenResolver.TransactOpts, attestations, values)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0xe49617e1.
+//
+// Solidity: function revoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_TokenResolver *TokenResolverTransactor) Revoke(opts *bind.TransactOpts, attestation Attestation) (*types.Transaction, error) {
+	return _TokenResolver.contract.Transact(opts, "revoke", attestation)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0xe49617e1.
+//
+// Solidity: function revoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_TokenResolver *TokenResolverSession) Revoke(attestation Attestation) (*types.Transaction, error) {
+	return _TokenResolver.Contract.Revoke(&_TokenResolver.TransactOpts, attestation)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0xe49617e1.
+//
+// Solidity: function revoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_TokenResolver *TokenResolverTransactorSession) Revoke(attestation Attestation) (*types.Transaction, error) {
+	return _TokenResolver.Contract.Revoke(&_TokenResolver.TransactOpts, attestation)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_TokenResolver *TokenResolverTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _TokenResolver.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_TokenResolver *TokenResolverSession) Receive() (*types.Transaction, error) {
+	return _TokenResolver.Contract.Receive(&_TokenResolver.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_TokenResolver *TokenResolverTransactorSession) Receive() (*types.Transaction, error) {
+	return _TokenResolver.Contract.Receive(&_TokenResolver.TransactOpts)
+}
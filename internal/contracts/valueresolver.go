@@ -0,0 +1,401 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ValueResolverMetaData contains all meta data concerning the ValueResolver contract.
+var ValueResolverMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"contractIEAS\",\"name\":\"eas\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"targetValue\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"AccessDenied\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InsufficientValue\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidEAS\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidLength\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotPayable\",\"type\":\"error\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"schema\",\"type\":\"bytes32\"},{\"internalType\":\"uint64\",\"name\":\"time\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"expirationTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"revocationTime\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"refUID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"revocable\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structAttestation\",\"name\":\"attestation\",\"type\":\"tuple\"}],\"name\":\"attest\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTargetValue\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isPayable\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"schema\",\"type\":\"bytes32\"},{\"internalType\":\"uint64\",\"name\":\"time\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"expirationTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"revocationTime\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"refUID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"revocable\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structAttestation[]\",\"name\":\"attestations\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256[]\",\"name\":\"values\",\"type\":\"uint256[]\"}],\"name\":\"multiAttest\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"schema\",\"type\":\"bytes32\"},{\"internalType\":\"uint64\",\"name\":\"time\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"expirationTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"revocationTime\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"refUID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"revocable\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structAttestation[]\",\"name\":\"attestations\",\"type\":\"tuple[]\"},{\"internalType\":\"uint256[]\",\"name\":\"values\",\"type\":\"uint256[]\"}],\"name\":\"multiRevoke\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"uid\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"schema\",\"type\":\"bytes32\"},{\"internalType\":\"uint64\",\"name\":\"time\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"expirationTime\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"revocationTime\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"refUID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"revocable\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"internalType\":\"structAttestation\",\"name\":\"attestation\",\"type\":\"tuple\"}],\"name\":\"revoke\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"version\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
+	Bin: "0x60c060405234801561001057600080fd5b5060405161076938038061076983398101604081905261002f9161006c565b816001600160a01b038116610057576040516341bc07ff60e11b815260040160405180910390fd5b6001600160a01b031660805260a052506100a6565b6000806040838503121561007f57600080fd5b82516001600160a01b038116811461009657600080fd5b6020939093015192949293505050565b60805160a05161067c6100ed6000396000818160cf0152818161036a015261045e01526000818161017501528181610297015281816103ca015261041e015261067c6000f3fe6080604052600436106100745760003560e01c806391db0b7e1161004e57806391db0b7e1461011b578063ce46e0461461012e578063e49617e114610142578063e60c35051461015557600080fd5b806354fd4d50146100805780637e5d9d0d146100bd57806388e5b2d9146100f857600080fd5b3661007b57005b600080fd5b34801561008c57600080fd5b5060408051808201825260058152640312e302e360dc1b602082015290516100b49190610484565b60405180910390f35b3480156100c957600080fd5b506040517f000000000000000000000000000000000000000000000000000000000000000081526020016100b4565b61010b61010636600461051e565b610168565b60405190151581526020016100b4565b61010b61012936600461051e565b61028a565b34801561013a57600080fd5b50600161010b565b61010b61015036600461058a565b6103bd565b61010b61016336600461058a565b610411565b6000336001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146101b357604051634ca8886760e01b815260040160405180910390fd5b838281146101d45760405163251f56a160e21b815260040160405180910390fd5b3460005b8281101561027a5760008686838181106101f4576101f46105cd565b9050602002013590508281111561021e5760405163044044a560e21b815260040160405180910390fd5b61024b898984818110610233576102336105cd565b905060200281019061024591906105e3565b50600190565b61025c576000945050505050610282565b610266818461061a565b925050806102739061062d565b90506101d8565b506001925050505b949350505050565b6000336001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146102d557604051634ca8886760e01b815260040160405180910390fd5b838281146102f65760405163251f56a160e21b815260040160405180910390fd5b3460005b8281101561027a576000868683818110610316576103166105cd565b905060200201359050828111156103405760405163044044a560e21b815260040160405180910390fd5b61038e898984818110610355576103556105cd565b905060200281019061036791906105e3565b507f0000000000000000000000000000000000000000000000000000000000000000821490565b61039f576000945050505050610282565b6103a9818461061a565b925050806103b69061062d565b90506102fa565b6000336001600160a01b037f0000000000000000000000000000000000000000000000000000000000000000161461040857604051634ca8886760e01b815260040160405180910390fd5b60015b92915050565b6000336001600160a01b037f0000000000000000000000000000000000000000000000000000000000000000161461045c57604051634ca8886760e01b815260040160405180910390fd5b7f0000000000000000000000000000000000000000000000000000000000000000341461040b565b600060208083528351808285015260005b818110156104b157858101830151858201604001528201610495565b506000604082860101526040601f19601f8301168501019250505092915050565b60008083601f8401126104e457600080fd5b50813567ffffffffffffffff8111156104fc57600080fd5b6020830191508360208260051b850101111561051757600080fd5b9250929050565b6000806000806040858703121561053457600080fd5b843567ffffffffffffffff8082111561054c57600080fd5b610558888389016104d2565b9096509450602087013591508082111561057157600080fd5b5061057e878288016104d2565b95989497509550505050565b60006020828403121561059c57600080fd5b813567ffffffffffffffff8111156105b357600080fd5b820161014081850312156105c657600080fd5b9392505050565b634e487b7160e01b600052603260045260246000fd5b6000823561013e198336030181126105fa57600080fd5b9190910192915050565b634e487b7160e01b600052601160045260246000fd5b8181038181111561040b5761040b610604565b60006001820161063f5761063f610604565b506001019056fea264697066735822122082cf0ffd824c4cb2f60379f62689b3896e4c248992ec721ed2ad17426d19394064736f6c63430008150033",
+}
+
+// ValueResolverABI is the input ABI used to generate the binding from.
+// Deprecated: Use ValueResolverMetaData.ABI instead.
+var ValueResolverABI = ValueResolverMetaData.ABI
+
+// ValueResolverBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ValueResolverMetaData.Bin instead.
+var ValueResolverBin = ValueResolverMetaData.Bin
+
+// DeployValueResolver deploys a new Ethereum contract, binding an instance of ValueResolver to it.
+func DeployValueResolver(auth *bind.TransactOpts, backend bind.ContractBackend, eas common.Address, targetValue *big.Int) (common.Address, *types.Transaction, *ValueResolver, error) {
+	parsed, err := ValueResolverMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ValueResolverBin), backend, eas, targetValue)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ValueResolver{ValueResolverCaller: ValueResolverCaller{contract: contract}, ValueResolverTransactor: ValueResolverTransactor{contract: contract}, ValueResolverFilterer: ValueResolverFilterer{contract: contract}}, nil
+}
+
+// ValueResolver is an auto generated Go binding around an Ethereum contract.
+type ValueResolver struct {
+	ValueResolverCaller     // Read-only binding to the contract
+	ValueResolverTransactor // Write-only binding to the contract
+	ValueResolverFilterer   // Log filterer for contract events
+}
+
+// ValueResolverCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ValueResolverCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ValueResolverTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ValueResolverTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ValueResolverFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ValueResolverFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ValueResolverSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ValueResolverSession struct {
+	Contract     *ValueResolver    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ValueResolverCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ValueResolverCallerSession struct {
+	Contract *ValueResolverCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// ValueResolverTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ValueResolverTransactorSession struct {
+	Contract     *ValueResolverTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// ValueResolverRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ValueResolverRaw struct {
+	Contract *ValueResolver // Generic contract binding to access the raw methods on
+}
+
+// ValueResolverCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ValueResolverCallerRaw struct {
+	Contract *ValueResolverCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ValueResolverTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ValueResolverTransactorRaw struct {
+	Contract *ValueResolverTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewValueResolver creates a new instance of ValueResolver, bound to a specific deployed contract.
+func NewValueResolver(address common.Address, backend bind.ContractBackend) (*ValueResolver, error) {
+	contract, err := bindValueResolver(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ValueResolver{ValueResolverCaller: ValueResolverCaller{contract: contract}, ValueResolverTransactor: ValueResolverTransactor{contract: contract}, ValueResolverFilterer: ValueResolverFilterer{contract: contract}}, nil
+}
+
+// NewValueResolverCaller creates a new read-only instance of ValueResolver, bound to a specific deployed contract.
+func NewValueResolverCaller(address common.Address, caller bind.ContractCaller) (*ValueResolverCaller, error) {
+	contract, err := bindValueResolver(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ValueResolverCaller{contract: contract}, nil
+}
+
+// NewValueResolverTransactor creates a new write-only instance of ValueResolver, bound to a specific deployed contract.
+func NewValueResolverTransactor(address common.Address, transactor bind.ContractTransactor) (*ValueResolverTransactor, error) {
+	contract, err := bindValueResolver(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ValueResolverTransactor{contract: contract}, nil
+}
+
+// NewValueResolverFilterer creates a new log filterer instance of ValueResolver, bound to a specific deployed contract.
+func NewValueResolverFilterer(address common.Address, filterer bind.ContractFilterer) (*ValueResolverFilterer, error) {
+	contract, err := bindValueResolver(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ValueResolverFilterer{contract: contract}, nil
+}
+
+// bindValueResolver binds a generic wrapper to an already deployed contract.
+func bindValueResolver(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ValueResolverMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ValueResolver *ValueResolverRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ValueResolver.Contract.ValueResolverCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ValueResolver *ValueResolverRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ValueResolver.Contract.ValueResolverTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ValueResolver *ValueResolverRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ValueResolver.Contract.ValueResolverTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ValueResolver *ValueResolverCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ValueResolver.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ValueResolver *ValueResolverTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ValueResolver.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ValueResolver *ValueResolverTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ValueResolver.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetTargetValue is a free data retrieval call binding the contract method 0x7e5d9d0d.
+//
+// Solidity: function getTargetValue() view returns(uint256)
+func (_ValueResolver *ValueResolverCaller) GetTargetValue(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ValueResolver.contract.Call(opts, &out, "getTargetValue")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetTargetValue is a free data retrieval call binding the contract method 0x7e5d9d0d.
+//
+// Solidity: function getTargetValue() view returns(uint256)
+func (_ValueResolver *ValueResolverSession) GetTargetValue() (*big.Int, error) {
+	return _ValueResolver.Contract.GetTargetValue(&_ValueResolver.CallOpts)
+}
+
+// GetTargetValue is a free data retrieval call binding the contract method 0x7e5d9d0d.
+//
+// Solidity: function getTargetValue() view returns(uint256)
+func (_ValueResolver *ValueResolverCallerSession) GetTargetValue() (*big.Int, error) {
+	return _ValueResolver.Contract.GetTargetValue(&_ValueResolver.CallOpts)
+}
+
+// IsPayable is a free data retrieval call binding the contract method 0xce46e046.
+//
+// Solidity: function isPayable() pure returns(bool)
+func (_ValueResolver *ValueResolverCaller) IsPayable(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _ValueResolver.contract.Call(opts, &out, "isPayable")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsPayable is a free data retrieval call binding the contract method 0xce46e046.
+//
+// Solidity: function isPayable() pure returns(bool)
+func (_ValueResolver *ValueResolverSession) IsPayable() (bool, error) {
+	return _ValueResolver.Contract.IsPayable(&_ValueResolver.CallOpts)
+}
+
+// IsPayable is a free data retrieval call binding the contract method 0xce46e046.
+//
+// Solidity: function isPayable() pure returns(bool)
+func (_ValueResolver *ValueResolverCallerSession) IsPayable() (bool, error) {
+	return _ValueResolver.Contract.IsPayable(&_ValueResolver.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(string)
+func (_ValueResolver *ValueResolverCaller) Version(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ValueResolver.contract.Call(opts, &out, "version")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(string)
+func (_ValueResolver *ValueResolverSession) Version() (string, error) {
+	return _ValueResolver.Contract.Version(&_ValueResolver.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() pure returns(string)
+func (_ValueResolver *ValueResolverCallerSession) Version() (string, error) {
+	return _ValueResolver.Contract.Version(&_ValueResolver.CallOpts)
+}
+
+// Attest is a paid mutator transaction binding the contract method 0xe60c3505.
+//
+// Solidity: function attest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_ValueResolver *ValueResolverTransactor) Attest(opts *bind.TransactOpts, attestation Attestation) (*types.Transaction, error) {
+	return _ValueResolver.contract.Transact(opts, "attest", attestation)
+}
+
+// Attest is a paid mutator transaction binding the contract method 0xe60c3505.
+//
+// Solidity: function attest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_ValueResolver *ValueResolverSession) Attest(attestation Attestation) (*types.Transaction, error) {
+	return _ValueResolver.Contract.Attest(&_ValueResolver.TransactOpts, attestation)
+}
+
+// Attest is a paid mutator transaction binding the contract method 0xe60c3505.
+//
+// Solidity: function attest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_ValueResolver *ValueResolverTransactorSession) Attest(attestation Attestation) (*types.Transaction, error) {
+	return _ValueResolver.Contract.Attest(&_ValueResolver.TransactOpts, attestation)
+}
+
+// MultiAttest is a paid mutator transaction binding the contract method 0x91db0b7e.
+//
+// Solidity: function multiAttest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_ValueResolver *ValueResolverTransactor) MultiAttest(opts *bind.TransactOpts, attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _ValueResolver.contract.Transact(opts, "multiAttest", attestations, values)
+}
+
+// MultiAttest is a paid mutator transaction binding the contract method 0x91db0b7e.
+//
+// Solidity: function multiAttest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_ValueResolver *ValueResolverSession) MultiAttest(attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _ValueResolver.Contract.MultiAttest(&_ValueResolver.TransactOpts, attestations, values)
+}
+
+// MultiAttest is a paid mutator transaction binding the contract method 0x91db0b7e.
+//
+// Solidity: function multiAttest((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_ValueResolver *ValueResolverTransactorSession) MultiAttest(attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _ValueResolver.Contract.MultiAttest(&_ValueResolver.TransactOpts, attestations, values)
+}
+
+// MultiRevoke is a paid mutator transaction binding the contract method 0x88e5b2d9.
+//
+// Solidity: function multiRevoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_ValueResolver *ValueResolverTransactor) MultiRevoke(opts *bind.TransactOpts, attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _ValueResolver.contract.Transact(opts, "multiRevoke", attestations, values)
+}
+
+// MultiRevoke is a paid mutator transaction binding the contract method 0x88e5b2d9.
+//
+// Solidity: function multiRevoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_ValueResolver *ValueResolverSession) MultiRevoke(attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _ValueResolver.Contract.MultiRevoke(&_ValueResolver.TransactOpts, attestations, values)
+}
+
+// MultiRevoke is a paid mutator transaction binding the contract method 0x88e5b2d9.
+//
+// Solidity: function multiRevoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes)[] attestations, uint256[] values) payable returns(bool)
+func (_ValueResolver *ValueResolverTransactorSession) MultiRevoke(attestations []Attestation, values []*big.Int) (*types.Transaction, error) {
+	return _ValueResolver.Contract.MultiRevoke(&_ValueResolver.TransactOpts, attestations, values)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0xe49617e1.
+//
+// Solidity: function revoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_ValueResolver *ValueResolverTransactor) Revoke(opts *bind.TransactOpts, attestation Attestation) (*types.Transaction, error) {
+	return _ValueResolver.contract.Transact(opts, "revoke", attestation)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0xe49617e1.
+//
+// Solidity: function revoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_ValueResolver *ValueResolverSession) Revoke(attestation Attestation) (*types.Transaction, error) {
+	return _ValueResolver.Contract.Revoke(&_ValueResolver.TransactOpts, attestation)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0xe49617e1.
+//
+// Solidity: function revoke((bytes32,bytes32,uint64,uint64,uint64,bytes32,address,address,bool,bytes) attestation) payable returns(bool)
+func (_ValueResolver *ValueResolverTransactorSession) Revoke(attestation Attestation) (*types.Transaction, error) {
+	return _ValueResolver.Contract.Revoke(&_ValueResolver.TransactOpts, attestation)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_ValueResolver *ValueResolverTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ValueResolver.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_ValueResolver *ValueResolverSession) Receive() (*types.Transaction, error) {
+	return _ValueResolver.Contract.Receive(&_ValueResolver.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_ValueResolver *ValueResolverTransactorSession) Receive() (*types.Transaction, error) {
+	return _ValueResolver.Contract.Receive(&_ValueResolver.TransactOpts)
+}
@@ -0,0 +1,456 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"resenje.org/eas/internal/contracts"
+)
+
+var (
+	proxyAttestTypeHash = crypto.Keccak256([]byte("Attest(address attester,bytes32 schema,address recipient,uint64 expirationTime,bool revocable,bytes32 refUID,bytes data,uint256 value,uint64 deadline)"))
+	proxyRevokeTypeHash = crypto.Keccak256([]byte("Revoke(address revoker,bytes32 schema,bytes32 uid,uint256 value,uint64 deadline)"))
+)
+
+// Signature is an EIP-712 signature of a delegated request.
+type Signature struct {
+	V uint8
+	R [32]byte
+	S [32]byte
+}
+
+// DelegatedProxyAttestation is an attestation request signed by the attester
+// that can be submitted to the EIP712Proxy contract by any account. Zero
+// Deadline means that the request does not expire.
+type DelegatedProxyAttestation struct {
+	Schema         UID
+	Recipient      common.Address
+	ExpirationTime time.Time
+	Revocable      bool
+	RefUID         UID
+	Data           []byte
+	Value          *big.Int
+	Attester       common.Address
+	Deadline       time.Time
+	Signature      Signature
+}
+
+func (a *DelegatedProxyAttestation) requestData() contracts.AttestationRequestData {
+	return newAttestationRequestData(a.Data, &AttestOptions{
+		Recipient:      a.Recipient,
+		ExpirationTime: a.ExpirationTime,
+		Revocable:      a.Revocable,
+		RefUID:         a.RefUID,
+		Value:          a.Value,
+	})
+}
+
+// DelegatedProxyRevocation is a revocation request signed by the attester that
+// can be submitted to the EIP712Proxy contract by any account. Zero Deadline
+// means that the request does not expire.
+type DelegatedProxyRevocation struct {
+	Schema    UID
+	UID       UID
+	Value     *big.Int
+	Revoker   common.Address
+	Deadline  time.Time
+	Signature Signature
+}
+
+func (r *DelegatedProxyRevocation) requestData() contracts.RevocationRequestData {
+	return newRevocationRequestData(r.UID, &RevokeOptions{Value: r.Value})
+}
+
+// ProxyContract signs and submits delegated attestations and revocations
+// through the EAS EIP712Proxy contract. The proxy contract is the attester of
+// such attestations in EAS, and the account that signed them can be resolved
+// with GetAttester.
+type ProxyContract struct {
+	client          *Client
+	address         common.Address
+	contract        *contracts.EIP712Proxy
+	abi             *abi.ABI
+	name            string
+	domainSeparator [32]byte
+}
+
+// NewProxyContract constructs the EIP712Proxy contract on the provided
+// address. Proxy contracts with different names can be deployed for the same
+// EAS contract. An error that matches ErrUnsupportedVersion is returned if the
+// EAS or the proxy contract version does not support delegated requests
+// signed by the SDK.
+func NewProxyContract(ctx context.Context, client *Client, address common.Address) (*ProxyContract, error) {
+	contract, err := contracts.NewEIP712Proxy(address, client.backend)
+	if err != nil {
+		return nil, fmt.Errorf("construct abi bindings: %w", err)
+	}
+
+	abi, err := contracts.EIP712ProxyMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("get abi: %w", err)
+	}
+
+	c := &ProxyContract{
+		client:   client,
+		address:  address,
+		contract: contract,
+		abi:      abi,
+	}
+
+	if !client.options.SkipVersionCheck {
+		v, err := c.Version(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get version: %w", err)
+		}
+		if err := checkProxyVersion(client.easVersion, v); err != nil {
+			return nil, err
+		}
+	}
+
+	name, err := contract.GetName(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("get name: %w", c.unpackError(err))
+	}
+	c.name = name
+
+	domainSeparator, err := contract.GetDomainSeparator(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("get domain separator: %w", c.unpackError(err))
+	}
+	c.domainSeparator = domainSeparator
+
+	return c, nil
+}
+
+// unpackError decodes errors also with the EAS contract ABI, as errors in EAS
+// are propagated by the proxy contract.
+func (c *ProxyContract) unpackError(err error) error {
+	return unpackError(err, append(c.client.errorABIsFor(c.abi, common.Address{}), c.client.EAS.abi)...)
+}
+
+func (c *ProxyContract) Address() common.Address {
+	return c.address
+}
+
+// Name returns the name of the proxy contract which is a part of its EIP-712
+// domain.
+func (c *ProxyContract) Name() string {
+	return c.name
+}
+
+func (c *ProxyContract) Version(ctx context.Context) (string, error) {
+	v, err := c.contract.Version(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return "", c.unpackError(err)
+	}
+	return v, nil
+}
+
+// GetAttester returns the address of the account that signed the attestation
+// submitted through the proxy contract, or a zero address if the attestation
+// was not submitted through it.
+func (c *ProxyContract) GetAttester(ctx context.Context, uid UID) (common.Address, error) {
+	a, err := c.contract.GetAttester(&bind.CallOpts{Context: ctx}, uid)
+	if err != nil {
+		return common.Address{}, c.unpackError(err)
+	}
+	return a, nil
+}
+
+// SignAttest returns the attestation request signed by the client account that
+// can be submitted by any account with AttestByDelegation or
+// MultiAttestByDelegation.
+func (c *ProxyContract) SignAttest(schemaUID UID, o *AttestOptions, deadline time.Time, values ...any) (*DelegatedProxyAttestation, error) {
+	data, err := encodeAttestationValues(values)
+	if err != nil {
+		return nil, fmt.Errorf("encode attestation values: %w", err)
+	}
+
+	r := newAttestationRequestData(data, o)
+
+	a := &DelegatedProxyAttestation{
+		Schema:    schemaUID,
+		Recipient: r.Recipient,
+		Revocable: r.Revocable,
+		RefUID:    r.RefUID,
+		Data:      data,
+		Value:     r.Value,
+		Attester:  c.client.account,
+		Deadline:  deadline,
+	}
+	if o != nil {
+		a.ExpirationTime = o.ExpirationTime
+	}
+
+	digest := c.hashTypedData(
+		proxyAttestTypeHash,
+		encodeWord(a.Attester),
+		encodeWord(a.Schema),
+		encodeWord(r.Recipient),
+		encodeWord(r.ExpirationTime),
+		encodeWord(r.Revocable),
+		encodeWord(r.RefUID),
+		crypto.Keccak256(r.Data),
+		encodeWord(r.Value),
+		encodeWord(unixTime(deadline)),
+	)
+
+	a.Signature, err = c.sign(digest)
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// SignRevoke returns the revocation request signed by the client account that
+// can be submitted by any account with RevokeByDelegation or
+// MultiRevokeByDelegation. Only attestations submitted through the proxy
+// contract can be revoked by their original attester.
+func (c *ProxyContract) SignRevoke(schemaUID, attestationUID UID, o *RevokeOptions, deadline time.Time) (*DelegatedProxyRevocation, error) {
+	d := newRevocationRequestData(attestationUID, o)
+
+	r := &DelegatedProxyRevocation{
+		Schema:   schemaUID,
+		UID:      attestationUID,
+		Value:    d.Value,
+		Revoker:  c.client.account,
+		Deadline: deadline,
+	}
+
+	digest := c.hashTypedData(
+		proxyRevokeTypeHash,
+		encodeWord(r.Revoker),
+		encodeWord(r.Schema),
+		encodeWord(r.UID),
+		encodeWord(r.Value),
+		encodeWord(unixTime(deadline)),
+	)
+
+	var err error
+	r.Signature, err = c.sign(digest)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (c *ProxyContract) AttestByDelegation(ctx context.Context, a *DelegatedProxyAttestation) (*types.Transaction, WaitTx[EASAttested], error) {
+	request := contracts.DelegatedProxyAttestationRequest{
+		Schema:    a.Schema,
+		Data:      a.requestData(),
+		Signature: contracts.EIP712Signature(a.Signature),
+		Attester:  a.Attester,
+		Deadline:  unixTime(a.Deadline),
+	}
+
+	txOpts, err := c.newTxOpts(ctx, request.Data.Value, "attestByDelegation", request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := c.contract.AttestByDelegation(txOpts, request)
+	if err != nil {
+		return nil, nil, fmt.Errorf("call attestByDelegation contract method: %w", c.unpackError(err))
+	}
+
+	return tx, c.client.EAS.WaitAttest(tx), nil
+}
+
+// MultiAttestByDelegation submits multiple delegated attestations in a single
+// transaction. Attestations may be signed by different attesters.
+func (c *ProxyContract) MultiAttestByDelegation(ctx context.Context, attestations ...*DelegatedProxyAttestation) (*types.Transaction, WaitTxMulti[EASAttested], error) {
+	if len(attestations) == 0 {
+		return nil, nil, errors.New("no attestations")
+	}
+
+	value := big.NewInt(0)
+	var requests []contracts.MultiDelegatedProxyAttestationRequest
+	for i, a := range attestations {
+		d := a.requestData()
+		value.Add(value, d.Value)
+		deadline := unixTime(a.Deadline)
+
+		// group consecutive attestations with the same schema, attester and
+		// deadline into a single request
+		if i > 0 {
+			p := attestations[i-1]
+			if p.Schema == a.Schema && p.Attester == a.Attester && p.Deadline.Equal(a.Deadline) {
+				r := &requests[len(requests)-1]
+				r.Data = append(r.Data, d)
+				r.Signatures = append(r.Signatures, contracts.EIP712Signature(a.Signature))
+				continue
+			}
+		}
+
+		requests = append(requests, contracts.MultiDelegatedProxyAttestationRequest{
+			Schema:     a.Schema,
+			Data:       []contracts.AttestationRequestData{d},
+			Signatures: []contracts.EIP712Signature{contracts.EIP712Signature(a.Signature)},
+			Attester:   a.Attester,
+			Deadline:   deadline,
+		})
+	}
+
+	txOpts, err := c.newTxOpts(ctx, value, "multiAttestByDelegation", requests)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := c.contract.MultiAttestByDelegation(txOpts, requests)
+	if err != nil {
+		return nil, nil, fmt.Errorf("call multiAttestByDelegation contract method: %w", c.unpackError(err))
+	}
+
+	return tx, c.client.EAS.WaitMultiAttest(tx), nil
+}
+
+func (c *ProxyContract) RevokeByDelegation(ctx context.Context, r *DelegatedProxyRevocation) (*types.Transaction, WaitTx[EASRevoked], error) {
+	request := contracts.DelegatedProxyRevocationRequest{
+		Schema:    r.Schema,
+		Data:      r.requestData(),
+		Signature: contracts.EIP712Signature(r.Signature),
+		Revoker:   r.Revoker,
+		Deadline:  unixTime(r.Deadline),
+	}
+
+	txOpts, err := c.newTxOpts(ctx, request.Data.Value, "revokeByDelegation", request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := c.contract.RevokeByDelegation(txOpts, request)
+	if err != nil {
+		return nil, nil, fmt.Errorf("call revokeByDelegation contract method: %w", c.unpackError(err))
+	}
+
+	return tx, c.client.EAS.WaitRevoke(tx), nil
+}
+
+// MultiRevokeByDelegation submits multiple delegated revocations in a single
+// transaction. Revocations may be signed by different attesters.
+func (c *ProxyContract) MultiRevokeByDelegation(ctx context.Context, revocations ...*DelegatedProxyRevocation) (*types.Transaction, WaitTxMulti[EASRevoked], error) {
+	if len(revocations) == 0 {
+		return nil, nil, errors.New("no revocations")
+	}
+
+	value := big.NewInt(0)
+	var requests []contracts.MultiDelegatedProxyRevocationRequest
+	for i, r := range revocations {
+		d := r.requestData()
+		value.Add(value, d.Value)
+
+		// group consecutive revocations with the same schema, revoker and
+		// deadline into a single request
+		if i > 0 {
+			p := revocations[i-1]
+			if p.Schema == r.Schema && p.Revoker == r.Revoker && p.Deadline.Equal(r.Deadline) {
+				m := &requests[len(requests)-1]
+				m.Data = append(m.Data, d)
+				m.Signatures = append(m.Signatures, contracts.EIP712Signature(r.Signature))
+				continue
+			}
+		}
+
+		requests = append(requests, contracts.MultiDelegatedProxyRevocationRequest{
+			Schema:     r.Schema,
+			Data:       []contracts.RevocationRequestData{d},
+			Signatures: []contracts.EIP712Signature{contracts.EIP712Signature(r.Signature)},
+			Revoker:    r.Revoker,
+			Deadline:   unixTime(r.Deadline),
+		})
+	}
+
+	txOpts, err := c.newTxOpts(ctx, value, "multiRevokeByDelegation", requests)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := c.contract.MultiRevokeByDelegation(txOpts, requests)
+	if err != nil {
+		return nil, nil, fmt.Errorf("call multiRevokeByDelegation contract method: %w", c.unpackError(err))
+	}
+
+	return tx, c.client.EAS.WaitMultiRevoke(tx), nil
+}
+
+// newTxOpts simulates the proxy contract method call if it is enabled in
+// client options and returns transaction options with the provided value.
+func (c *ProxyContract) newTxOpts(ctx context.Context, value *big.Int, method string, args ...any) (*bind.TransactOpts, error) {
+	if c.client.options.Simulate {
+		if _, err := c.client.simulate(ctx, c.address, c.abi, value, method, args...); err != nil {
+			return nil, fmt.Errorf("simulate %s: %w", method, c.unpackError(err))
+		}
+	}
+
+	txOpts, err := c.client.newTxOpts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("construct transaction options: %w", err)
+	}
+	txOpts.Value = value
+
+	return txOpts, nil
+}
+
+func (c *ProxyContract) hashTypedData(words ...[]byte) []byte {
+	return crypto.Keccak256([]byte("\x19\x01"), c.domainSeparator[:], crypto.Keccak256(words...))
+}
+
+func (c *ProxyContract) sign(digest []byte) (Signature, error) {
+	sig, err := crypto.Sign(digest, c.client.pk)
+	if err != nil {
+		return Signature{}, fmt.Errorf("sign: %w", err)
+	}
+
+	var s Signature
+	copy(s.R[:], sig[:32])
+	copy(s.S[:], sig[32:64])
+	s.V = sig[64] + 27
+	return s, nil
+}
+
+// encodeWord returns the ABI encoding of a static type value as a 32 bytes
+// word.
+func encodeWord(v any) []byte {
+	switch v := v.(type) {
+	case common.Address:
+		return common.LeftPadBytes(v.Bytes(), 32)
+	case UID:
+		return v[:]
+	case [32]byte:
+		return v[:]
+	case uint64:
+		return common.LeftPadBytes(new(big.Int).SetUint64(v).Bytes(), 32)
+	case bool:
+		if v {
+			return common.LeftPadBytes([]byte{1}, 32)
+		}
+		return make([]byte, 32)
+	case *big.Int:
+		return math.U256Bytes(new(big.Int).Set(v))
+	}
+	panic(fmt.Sprintf("unsupported word type %T", v))
+}
+
+func unixTime(t time.Time) uint64 {
+	if t.IsZero() {
+		return 0
+	}
+	return uint64(t.Unix())
+}
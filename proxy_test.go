@@ -0,0 +1,159 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"resenje.org/eas"
+	"resenje.org/eas/eastest"
+)
+
+func TestProxyContract(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, &eastest.Options{Accounts: 2})
+	relayer := h.Accounts[0].Client
+	signer := h.Accounts[1].Client
+	proxy := relayer.EIP712Proxy
+
+	assertEqual(t, "address", proxy.Address(), h.Addresses.EIP712Proxy)
+	assertEqual(t, "name", proxy.Name(), eastest.EIP712ProxyName)
+
+	version, err := proxy.Version(ctx)
+	assertNilError(t, err)
+	assertEqual(t, "version", version, "1.3.0")
+
+	schemaUID := registerSchema(t, &Client{Client: relayer, backend: h.Simulated}, "string message")
+
+	a, err := signer.EIP712Proxy.SignAttest(schemaUID, &eas.AttestOptions{Revocable: true}, h.BlockTime(t).Add(time.Hour), "Hello!")
+	assertNilError(t, err)
+	assertEqual(t, "attester", a.Attester, signer.Address())
+
+	_, wait, err := proxy.AttestByDelegation(ctx, a)
+	assertNilError(t, err)
+
+	h.Commit()
+
+	attested, err := wait(ctx)
+	assertNilError(t, err)
+	assertEqual(t, "attested attester", attested.Attester, h.Addresses.EIP712Proxy)
+	assertEqual(t, "schema", attested.Schema, schemaUID)
+
+	attester, err := proxy.GetAttester(ctx, attested.UID)
+	assertNilError(t, err)
+	assertEqual(t, "attester", attester, signer.Address())
+
+	attestation, err := relayer.EAS.GetAttestation(ctx, attested.UID)
+	assertNilError(t, err)
+	assertEqual(t, "attestation attester", attestation.Attester, h.Addresses.EIP712Proxy)
+
+	var message string
+	assertNilError(t, attestation.ScanValues(&message))
+	assertEqual(t, "message", message, "Hello!")
+
+	t.Run("used signature", func(t *testing.T) {
+		_, _, err := proxy.AttestByDelegation(ctx, a)
+		assertErrorIs(t, err, eas.ErrUsedSignature)
+	})
+
+	t.Run("deadline expired", func(t *testing.T) {
+		a, err := signer.EIP712Proxy.SignAttest(schemaUID, nil, h.BlockTime(t).Add(-time.Hour), "Hello!")
+		assertNilError(t, err)
+
+		_, _, err = proxy.AttestByDelegation(ctx, a)
+		assertErrorIs(t, err, eas.ErrDeadlineExpired)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		a, err := signer.EIP712Proxy.SignAttest(schemaUID, nil, time.Time{}, "Hello!")
+		assertNilError(t, err)
+
+		a.Recipient = common.HexToAddress("0x1")
+
+		_, _, err = proxy.AttestByDelegation(ctx, a)
+		assertErrorIs(t, err, eas.ErrInvalidSignature)
+	})
+
+	t.Run("revoke access denied", func(t *testing.T) {
+		r, err := relayer.EIP712Proxy.SignRevoke(schemaUID, attested.UID, nil, time.Time{})
+		assertNilError(t, err)
+
+		_, _, err = proxy.RevokeByDelegation(ctx, r)
+		assertErrorIs(t, err, eas.ErrAccessDenied)
+	})
+
+	r, err := signer.EIP712Proxy.SignRevoke(schemaUID, attested.UID, nil, time.Time{})
+	assertNilError(t, err)
+
+	_, waitRevoke, err := proxy.RevokeByDelegation(ctx, r)
+	assertNilError(t, err)
+
+	h.Commit()
+
+	revoked, err := waitRevoke(ctx)
+	assertNilError(t, err)
+	assertEqual(t, "revoked uid", revoked.UID, attested.UID)
+
+	attestation, err = relayer.EAS.GetAttestation(ctx, attested.UID)
+	assertNilError(t, err)
+	assertEqual(t, "revoked", attestation.IsRevoked(), true)
+}
+
+func TestProxyContract_multi(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, &eastest.Options{Accounts: 3})
+	relayer := h.Accounts[0].Client
+	proxy := relayer.EIP712Proxy
+
+	schemaUID := registerSchema(t, &Client{Client: relayer, backend: h.Simulated}, "string message")
+
+	var (
+		attestations []*eas.DelegatedProxyAttestation
+		attesters    []common.Address
+	)
+	for i, account := range []eastest.Account{h.Accounts[1], h.Accounts[1], h.Accounts[2]} {
+		a, err := account.Client.EIP712Proxy.SignAttest(schemaUID, &eas.AttestOptions{Revocable: true}, time.Time{}, fmt.Sprintf("Hello %v!", i))
+		assertNilError(t, err)
+		attestations = append(attestations, a)
+		attesters = append(attesters, account.Client.Address())
+	}
+
+	_, wait, err := proxy.MultiAttestByDelegation(ctx, attestations...)
+	assertNilError(t, err)
+
+	h.Commit()
+
+	attested, err := wait(ctx)
+	assertNilError(t, err)
+	assertEqual(t, "attestations", len(attested), len(attestations))
+
+	var revocations []*eas.DelegatedProxyRevocation
+	for i, a := range attested {
+		attester, err := proxy.GetAttester(ctx, a.UID)
+		assertNilError(t, err)
+		assertEqual(t, "attester", attester, attesters[i])
+
+		r, err := h.Accounts[1+i/2].Client.EIP712Proxy.SignRevoke(schemaUID, a.UID, nil, time.Time{})
+		assertNilError(t, err)
+		revocations = append(revocations, r)
+	}
+
+	_, waitRevoke, err := proxy.MultiRevokeByDelegation(ctx, revocations...)
+	assertNilError(t, err)
+
+	h.Commit()
+
+	revoked, err := waitRevoke(ctx)
+	assertNilError(t, err)
+	assertEqual(t, "revocations", len(revoked), len(attestations))
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"golang.org/x/time/rate"
+)
+
+// RateLimits configure client-side limits of backend requests with separate
+// token bucket budgets for different kinds of requests. Requests wait for the
+// budget until their context is done. A nil budget is not limited.
+type RateLimits struct {
+	// Calls limits contract calls and all other requests that are not logs
+	// queries or sent transactions.
+	Calls *RateLimit
+	// Logs limits FilterLogs and SubscribeFilterLogs requests.
+	Logs *RateLimit
+	// Sends limits SendTransaction requests.
+	Sends *RateLimit
+}
+
+// RateLimit is a token bucket budget of requests.
+type RateLimit struct {
+	// RequestsPerSecond is the rate at which the budget is refilled. It must
+	// be greater than zero, and NewClient returns an error otherwise. A nil
+	// RateLimit is used for requests that are not limited.
+	RequestsPerSecond float64
+	// Burst is the maximal number of requests that can be made at once.
+	// Default is RequestsPerSecond rounded up, but at least one.
+	Burst int
+}
+
+func (l RateLimits) validate() error {
+	for _, v := range []struct {
+		name  string
+		limit *RateLimit
+	}{
+		{name: "calls", limit: l.Calls},
+		{name: "logs", limit: l.Logs},
+		{name: "sends", limit: l.Sends},
+	} {
+		if v.limit != nil && !(v.limit.RequestsPerSecond > 0) {
+			return fmt.Errorf("%s rate limit: requests per second %v not greater than zero", v.name, v.limit.RequestsPerSecond)
+		}
+	}
+	return nil
+}
+
+func (l *RateLimit) limiter() *rate.Limiter {
+	if l == nil {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+	burst := l.Burst
+	if burst <= 0 {
+		burst = max(1, int(math.Ceil(l.RequestsPerSecond)))
+	}
+	return rate.NewLimiter(rate.Limit(l.RequestsPerSecond), burst)
+}
+
+// rateLimitBackend waits for the budget of the request kind before calling
+// the wrapped backend.
+type rateLimitBackend struct {
+	backend Backend
+	calls   *rate.Limiter
+	logs    *rate.Limiter
+	sends   *rate.Limiter
+}
+
+func newRateLimitBackend(backend Backend, l RateLimits) *rateLimitBackend {
+	return &rateLimitBackend{
+		backend: backend,
+		calls:   l.Calls.limiter(),
+		logs:    l.Logs.limiter(),
+		sends:   l.Sends.limiter(),
+	}
+}
+
+// rateLimit calls the function when the limiter allows it.
+func rateLimit[T any](ctx context.Context, l *rate.Limiter, f func() (T, error)) (T, error) {
+	return rateLimitN(ctx, l, 1, f)
+}
+
+// rateLimitN calls the function when the limiter allows n requests.
+func rateLimitN[T any](ctx context.Context, l *rate.Limiter, n int, f func() (T, error)) (T, error) {
+	if err := l.WaitN(ctx, n); err != nil {
+		var zero T
+		return zero, fmt.Errorf("rate limit: %w", err)
+	}
+	return f()
+}
+
+func (b *rateLimitBackend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return rateLimit(ctx, b.calls, func() ([]byte, error) {
+		return b.backend.CodeAt(ctx, contract, blockNumber)
+	})
+}
+
+func (b *rateLimitBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return rateLimit(ctx, b.calls, func() ([]byte, error) {
+		return b.backend.CallContract(ctx, call, blockNumber)
+	})
+}
+
+func (b *rateLimitBackend) PendingCallContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error) {
+	return rateLimit(ctx, b.calls, func() ([]byte, error) {
+		if pending, ok := b.backend.(ethereum.PendingContractCaller); ok {
+			return pending.PendingCallContract(ctx, call)
+		}
+		return b.backend.CallContract(ctx, call, nil)
+	})
+}
+
+func (b *rateLimitBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return rateLimit(ctx, b.calls, func() (*types.Header, error) {
+		return b.backend.HeaderByNumber(ctx, number)
+	})
+}
+
+func (b *rateLimitBackend) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return rateLimit(ctx, b.calls, func() ([]byte, error) {
+		return b.backend.PendingCodeAt(ctx, account)
+	})
+}
+
+func (b *rateLimitBackend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return rateLimit(ctx, b.calls, func() (uint64, error) {
+		return b.backend.PendingNonceAt(ctx, account)
+	})
+}
+
+func (b *rateLimitBackend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return rateLimit(ctx, b.calls, func() (*big.Int, error) {
+		return b.backend.SuggestGasPrice(ctx)
+	})
+}
+
+func (b *rateLimitBackend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return rateLimit(ctx, b.calls, func() (*big.Int, error) {
+		return b.backend.SuggestGasTipCap(ctx)
+	})
+}
+
+func (b *rateLimitBackend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return rateLimit(ctx, b.calls, func() (uint64, error) {
+		return b.backend.EstimateGas(ctx, call)
+	})
+}
+
+func (b *rateLimitBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	_, err := rateLimit(ctx, b.sends, func() (struct{}, error) {
+		return struct{}{}, b.backend.SendTransaction(ctx, tx)
+	})
+	return err
+}
+
+func (b *rateLimitBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return rateLimit(ctx, b.logs, func() ([]types.Log, error) {
+		return b.backend.FilterLogs(ctx, query)
+	})
+}
+
+func (b *rateLimitBackend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return rateLimit(ctx, b.logs, func() (ethereum.Subscription, error) {
+		return b.backend.SubscribeFilterLogs(ctx, query, ch)
+	})
+}
+
+func (b *rateLimitBackend) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return rateLimit(ctx, b.calls, func() (*types.Receipt, error) {
+		return b.backend.TransactionReceipt(ctx, txHash)
+	})
+}
+
+func (b *rateLimitBackend) ChainID(ctx context.Context) (*big.Int, error) {
+	return rateLimit(ctx, b.calls, func() (*big.Int, error) {
+		return b.backend.ChainID(ctx)
+	})
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"resenje.org/eas"
+	"resenje.org/eas/eastest"
+)
+
+func TestClient_rateLimits(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, nil)
+
+	newClient := func(t *testing.T, l *eas.RateLimits) *eas.Client {
+		t.Helper()
+
+		c, err := eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EAS, &eas.Options{
+			Backend:          h.Backend(),
+			RateLimits:       l,
+			SkipVersionCheck: true,
+		})
+		assertNilError(t, err)
+		return c
+	}
+
+	t.Run("calls", func(t *testing.T) {
+		c := newClient(t, &eas.RateLimits{
+			Calls: &eas.RateLimit{RequestsPerSecond: 50, Burst: 1},
+		})
+
+		start := time.Now()
+		for range 6 {
+			_, err := c.SchemaRegistry.Version(ctx)
+			assertNilError(t, err)
+		}
+		if d := time.Since(start); d < 80*time.Millisecond {
+			t.Errorf("got duration %v, want at least 80ms", d)
+		}
+	})
+
+	t.Run("invalid rate", func(t *testing.T) {
+		for _, rps := range []float64{0, -1} {
+			_, err := eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EAS, &eas.Options{
+				Backend: h.Backend(),
+				RateLimits: &eas.RateLimits{
+					Logs: &eas.RateLimit{RequestsPerSecond: rps, Burst: 1},
+				},
+			})
+			if err == nil {
+				t.Fatalf("requests per second %v: expected error", rps)
+			}
+		}
+	})
+
+	t.Run("separate budgets", func(t *testing.T) {
+		c := newClient(t, &eas.RateLimits{
+			Logs: &eas.RateLimit{RequestsPerSecond: 0.001, Burst: 1},
+		})
+
+		filter := func(ctx context.Context) error {
+			it, err := c.EAS.FilterAttested(ctx, 0, nil, nil, nil, nil)
+			if err != nil {
+				return err
+			}
+			defer it.Close()
+
+			for it.Next() {
+			}
+			return it.Error()
+		}
+
+		assertNilError(t, filter(ctx))
+
+		start := time.Now()
+		for range 10 {
+			_, err := c.SchemaRegistry.Version(ctx)
+			assertNilError(t, err)
+		}
+		if d := time.Since(start); d > time.Second {
+			t.Errorf("got duration %v, want calls not limited", d)
+		}
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+
+		if err := filter(timeoutCtx); err == nil {
+			t.Fatal("expected error")
+		}
+
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		assertErrorIs(t, filter(canceledCtx), context.Canceled)
+	})
+}
+
+func TestClient_rateLimits_batch(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, &eastest.Options{HTTP: true})
+
+	transport := new(faultTransport)
+	rpcClient, err := rpc.DialOptions(ctx, h.Endpoint(), rpc.WithHTTPClient(&http.Client{Transport: transport}))
+	assertNilError(t, err)
+	defer rpcClient.Close()
+
+	c, err := eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EAS, &eas.Options{
+		Backend:          ethclient.NewClient(rpcClient),
+		SkipVersionCheck: true,
+		RateLimits: &eas.RateLimits{
+			Calls: &eas.RateLimit{RequestsPerSecond: 50, Burst: 2},
+		},
+	})
+	assertNilError(t, err)
+
+	uids := make([]eas.UID, 6)
+	for i := range uids {
+		uids[i] = eas.UID{byte(i + 1)}
+	}
+
+	// every call in a batch request is counted, and batches are not larger
+	// than the burst
+	start := time.Now()
+	attestations, err := c.EAS.GetAttestations(ctx, uids)
+	assertNilError(t, err)
+	if d := time.Since(start); d < 80*time.Millisecond {
+		t.Errorf("got duration %v, want at least 80ms", d)
+	}
+	assertEqual(t, "attestations", len(attestations), len(uids))
+	assertEqual(t, "batch requests", transport.batches.Load(), int32(3))
+}
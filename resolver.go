@@ -0,0 +1,159 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"resenje.org/eas/internal/contracts"
+)
+
+// SchemaResolverContract provides information about a schema resolver
+// contract that implements the standard EAS SchemaResolver interface.
+type SchemaResolverContract struct {
+	client   *Client
+	address  common.Address
+	contract *contracts.SchemaResolver
+	abi      *abi.ABI
+}
+
+// NewSchemaResolverContract constructs the schema resolver contract on the
+// provided address.
+func NewSchemaResolverContract(client *Client, address common.Address) (*SchemaResolverContract, error) {
+	contract, err := contracts.NewSchemaResolver(address, client.backend)
+	if err != nil {
+		return nil, fmt.Errorf("construct abi bindings: %w", err)
+	}
+
+	abi, err := contracts.SchemaResolverMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("get abi: %w", err)
+	}
+
+	return &SchemaResolverContract{
+		client:   client,
+		address:  address,
+		contract: contract,
+		abi:      abi,
+	}, nil
+}
+
+func (c *SchemaResolverContract) unpackError(err error) error {
+	return unpackError(err, c.client.errorABIsFor(c.abi, c.address)...)
+}
+
+func (c *SchemaResolverContract) Address() common.Address {
+	return c.address
+}
+
+func (c *SchemaResolverContract) Version(ctx context.Context) (string, error) {
+	v, err := c.contract.Version(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return "", c.unpackError(err)
+	}
+	return v, nil
+}
+
+// IsPayable returns true if the resolver accepts attestations and revocations
+// with value. EAS contract rejects requests with value for schemas with
+// resolvers that are not payable.
+func (c *SchemaResolverContract) IsPayable(ctx context.Context) (bool, error) {
+	payable, err := c.contract.IsPayable(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return false, c.unpackError(err)
+	}
+	return payable, nil
+}
+
+// Attest calls the attest hook of the resolver as the EAS contract, without
+// sending a transaction, and returns whether the resolver accepts the
+// attestation with the value. Calls with value succeed only if the EAS
+// contract balance covers it.
+func (c *SchemaResolverContract) Attest(ctx context.Context, attestation *Attestation, value *big.Int) (bool, error) {
+	return c.callHook(ctx, value, "attest", newContractAttestation(attestation))
+}
+
+// MultiAttest calls the multiAttest hook of the resolver as the EAS contract,
+// without sending a transaction, and returns whether the resolver accepts
+// all attestations with their values.
+func (c *SchemaResolverContract) MultiAttest(ctx context.Context, attestations []*Attestation, values []*big.Int) (bool, error) {
+	return c.callHook(ctx, sumValues(values), "multiAttest", newContractAttestations(attestations), values)
+}
+
+// Revoke calls the revoke hook of the resolver as the EAS contract, without
+// sending a transaction, and returns whether the resolver accepts the
+// revocation of the attestation with the value.
+func (c *SchemaResolverContract) Revoke(ctx context.Context, attestation *Attestation, value *big.Int) (bool, error) {
+	return c.callHook(ctx, value, "revoke", newContractAttestation(attestation))
+}
+
+// MultiRevoke calls the multiRevoke hook of the resolver as the EAS
+// contract, without sending a transaction, and returns whether the resolver
+// accepts revocations of all attestations with their values.
+func (c *SchemaResolverContract) MultiRevoke(ctx context.Context, attestations []*Attestation, values []*big.Int) (bool, error) {
+	return c.callHook(ctx, sumValues(values), "multiRevoke", newContractAttestations(attestations), values)
+}
+
+func (c *SchemaResolverContract) callHook(ctx context.Context, value *big.Int, method string, args ...any) (bool, error) {
+	data, err := c.abi.Pack(method, args...)
+	if err != nil {
+		return false, fmt.Errorf("pack arguments: %w", err)
+	}
+	out, err := c.client.backend.CallContract(ctx, ethereum.CallMsg{
+		From:  c.client.easContractAddress,
+		To:    &c.address,
+		Value: value,
+		Data:  data,
+	}, nil)
+	if err != nil {
+		return false, c.unpackError(err)
+	}
+	values, err := c.abi.Unpack(method, out)
+	if err != nil {
+		return false, fmt.Errorf("unpack result: %w", err)
+	}
+	return convertValue[bool](values[0]), nil
+}
+
+func newContractAttestation(a *Attestation) contracts.Attestation {
+	return contracts.Attestation{
+		Uid:            a.UID,
+		Schema:         a.Schema,
+		Time:           unixTime(a.Time),
+		ExpirationTime: unixTime(a.ExpirationTime),
+		RevocationTime: unixTime(a.RevocationTime),
+		RefUID:         a.RefUID,
+		Recipient:      a.Recipient,
+		Attester:       a.Attester,
+		Revocable:      a.Revocable,
+		Data:           a.Data,
+	}
+}
+
+func newContractAttestations(attestations []*Attestation) []contracts.Attestation {
+	s := make([]contracts.Attestation, 0, len(attestations))
+	for _, a := range attestations {
+		s = append(s, newContractAttestation(a))
+	}
+	return s
+}
+
+func sumValues(values []*big.Int) *big.Int {
+	sum := big.NewInt(0)
+	for _, v := range values {
+		if v != nil {
+			sum.Add(sum, v)
+		}
+	}
+	return sum
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"resenje.org/eas"
+	"resenje.org/eas/eastest"
+)
+
+func TestSchemaResolverContract(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, &eastest.Options{Accounts: 2, AutoCommit: true})
+	allowed := h.Accounts[0].Client
+	denied := h.Accounts[1].Client
+	backend := h.Simulated.Client()
+
+	registerResolverSchema := func(t *testing.T, resolver common.Address) eas.UID {
+		t.Helper()
+
+		_, wait, err := allowed.SchemaRegistry.Register(ctx, "string message", resolver, true)
+		assertNilError(t, err)
+		r, err := wait(ctx)
+		assertNilError(t, err)
+		return r.UID
+	}
+
+	attest := func(c *eas.Client, schemaUID eas.UID, o *eas.AttestOptions) error {
+		_, wait, err := c.EAS.Attest(ctx, schemaUID, o, "Hello!")
+		if err != nil {
+			return err
+		}
+		_, err = wait(ctx)
+		return err
+	}
+
+	t.Run("attester", func(t *testing.T) {
+		resolverAddress := h.DeployAttesterResolver(t, []common.Address{allowed.Address()})
+
+		resolver, err := eas.NewSchemaResolverContract(allowed, resolverAddress)
+		assertNilError(t, err)
+
+		version, err := resolver.Version(ctx)
+		assertNilError(t, err)
+		assertEqual(t, "version", version, "1.0.0")
+
+		payable, err := resolver.IsPayable(ctx)
+		assertNilError(t, err)
+		assertEqual(t, "payable", payable, false)
+
+		schemaUID := registerResolverSchema(t, resolverAddress)
+
+		assertNilError(t, attest(allowed, schemaUID, nil))
+		assertErrorIs(t, attest(denied, schemaUID, nil), eas.ErrInvalidAttestation)
+		assertErrorIs(t, attest(allowed, schemaUID, &eas.AttestOptions{Value: big.NewInt(1)}), eas.ErrNotPayable)
+
+		accepted, err := resolver.Attest(ctx, &eas.Attestation{Schema: schemaUID, Attester: allowed.Address()}, nil)
+		assertNilError(t, err)
+		assertEqual(t, "accepted", accepted, true)
+
+		accepted, err = resolver.MultiAttest(ctx, []*eas.Attestation{
+			{Schema: schemaUID, Attester: allowed.Address()},
+			{Schema: schemaUID, Attester: denied.Address()},
+		}, []*big.Int{big.NewInt(0), big.NewInt(0)})
+		assertNilError(t, err)
+		assertEqual(t, "accepted", accepted, false)
+
+		accepted, err = resolver.Revoke(ctx, &eas.Attestation{Schema: schemaUID, Attester: allowed.Address()}, nil)
+		assertNilError(t, err)
+		assertEqual(t, "revocation accepted", accepted, true)
+
+		accepted, err = resolver.MultiRevoke(ctx, []*eas.Attestation{
+			{Schema: schemaUID, Attester: allowed.Address()},
+		}, []*big.Int{big.NewInt(0)})
+		assertNilError(t, err)
+		assertEqual(t, "revocation accepted", accepted, true)
+
+		_, err = resolver.MultiAttest(ctx, []*eas.Attestation{{Schema: schemaUID}}, nil)
+		assertErrorIs(t, err, eas.ErrInvalidLength)
+	})
+
+	t.Run("value", func(t *testing.T) {
+		value := big.NewInt(1000)
+
+		resolverAddress := h.DeployValueResolver(t, value)
+
+		resolver, err := eas.NewSchemaResolverContract(allowed, resolverAddress)
+		assertNilError(t, err)
+
+		payable, err := resolver.IsPayable(ctx)
+		assertNilError(t, err)
+		assertEqual(t, "payable", payable, true)
+
+		schemaUID := registerResolverSchema(t, resolverAddress)
+
+		assertNilError(t, attest(allowed, schemaUID, &eas.AttestOptions{Value: value}))
+		assertErrorIs(t, attest(allowed, schemaUID, &eas.AttestOptions{Value: big.NewInt(1)}), eas.ErrInvalidAttestation)
+
+		balance, err := backend.BalanceAt(ctx, resolverAddress, nil)
+		assertNilError(t, err)
+		assertEqual(t, "resolver balance", balance.Cmp(value), 0)
+	})
+
+	t.Run("expiration time", func(t *testing.T) {
+		validAfter := h.BlockTime(t).Add(24 * time.Hour)
+
+		resolverAddress := h.DeployExpirationTimeResolver(t, validAfter)
+
+		schemaUID := registerResolverSchema(t, resolverAddress)
+
+		assertNilError(t, attest(allowed, schemaUID, &eas.AttestOptions{ExpirationTime: validAfter.Add(time.Hour)}))
+		assertErrorIs(t, attest(allowed, schemaUID, &eas.AttestOptions{ExpirationTime: validAfter.Add(-time.Hour)}), eas.ErrInvalidAttestation)
+	})
+
+	t.Run("token", func(t *testing.T) {
+		minimalBalance := big.NewInt(100)
+
+		token := h.DeployToken(t, map[common.Address]*big.Int{
+			allowed.Address(): minimalBalance,
+			denied.Address():  big.NewInt(99),
+		})
+
+		resolverAddress := h.DeployTokenResolver(t, token, minimalBalance)
+
+		schemaUID := registerResolverSchema(t, resolverAddress)
+
+		assertNilError(t, attest(allowed, schemaUID, nil))
+		assertErrorIs(t, attest(denied, schemaUID, nil), eas.ErrInvalidAttestation)
+	})
+}
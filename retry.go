@@ -0,0 +1,293 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// RetryPolicy configures retries of backend calls that fail with transient
+// errors, such as rate limiting or unavailable RPC endpoints. Zero values of
+// fields are replaced with defaults.
+//
+// Sent transactions are signed only once and the same signed transaction is
+// broadcast on every attempt, so retries never send a transaction with a
+// different nonce or fees. A retried broadcast of a transaction that the node
+// already has is considered successful.
+type RetryPolicy struct {
+	// MaxAttempts is the maximal number of attempts of a call, including the
+	// first one. Default is 5.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry. Default is 100ms.
+	InitialBackoff time.Duration
+	// MaxBackoff limits the delay between attempts. Default is 10s.
+	MaxBackoff time.Duration
+	// Multiplier increases the delay after every retry. Default is 2.
+	Multiplier float64
+	// Jitter is the fraction of the delay that is randomly added or removed
+	// to spread retries of concurrent calls. Default is 0.2.
+	Jitter float64
+	// RetryableStatusCodes are HTTP status codes of responses that are
+	// retried. Default are 408, 425, 429, 500, 502, 503 and 504.
+	RetryableStatusCodes []int
+	// RetryableErrorCodes are JSON-RPC error codes that are retried. Default
+	// are -32005 (limit exceeded) and -32603 (internal error).
+	RetryableErrorCodes []int
+	// Retryable, if set, decides if the error is retryable instead of status
+	// and error codes.
+	Retryable func(err error) bool
+}
+
+var (
+	defaultRetryableStatusCodes = []int{
+		http.StatusRequestTimeout,
+		http.StatusTooEarly,
+		http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	}
+	defaultRetryableErrorCodes = []int{-32005, -32603}
+)
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = 5
+	}
+	if p.InitialBackoff <= 0 {
+		p.InitialBackoff = 100 * time.Millisecond
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = 10 * time.Second
+	}
+	if p.Multiplier < 1 {
+		p.Multiplier = 2
+	}
+	if p.Jitter <= 0 {
+		p.Jitter = 0.2
+	}
+	if p.RetryableStatusCodes == nil {
+		p.RetryableStatusCodes = defaultRetryableStatusCodes
+	}
+	if p.RetryableErrorCodes == nil {
+		p.RetryableErrorCodes = defaultRetryableErrorCodes
+	}
+	return p
+}
+
+// IsRetryable reports if the error is transient and the call can be retried
+// according to the policy.
+func (p RetryPolicy) IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	p = p.withDefaults()
+
+	if p.Retryable != nil {
+		return p.Retryable(err)
+	}
+
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return containsInt(p.RetryableStatusCodes, httpErr.StatusCode)
+	}
+
+	// logs queries with too many results are split by Filter methods
+	// instead of being retried
+	if isFilterLimitError(err) {
+		return false
+	}
+
+	// reverted executions are returned with revert data and are never
+	// retried
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) && dataErr.ErrorData() != nil {
+		return false
+	}
+
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return containsInt(p.RetryableErrorCodes, rpcErr.ErrorCode())
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	return errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET)
+}
+
+// backoff returns the delay before the retry that follows the attempt.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(attempt-1))
+	if d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+	d += d * p.Jitter * (2*rand.Float64() - 1)
+	return time.Duration(d)
+}
+
+func containsInt(s []int, v int) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+// retryBackend retries calls of the wrapped backend according to the policy.
+type retryBackend struct {
+	backend Backend
+	policy  RetryPolicy
+}
+
+func newRetryBackend(backend Backend, policy RetryPolicy) *retryBackend {
+	return &retryBackend{
+		backend: backend,
+		policy:  policy.withDefaults(),
+	}
+}
+
+// retry calls the function until it succeeds, returns an error that is not
+// retryable, or the maximal number of attempts is reached.
+func retry[T any](ctx context.Context, p RetryPolicy, f func(attempt int) (T, error)) (T, error) {
+	for attempt := 1; ; attempt++ {
+		v, err := f(attempt)
+		if err == nil || attempt >= p.MaxAttempts || !p.IsRetryable(err) {
+			return v, err
+		}
+
+		timer := time.NewTimer(p.backoff(attempt))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return v, err
+		}
+	}
+}
+
+func (b *retryBackend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return retry(ctx, b.policy, func(int) ([]byte, error) {
+		return b.backend.CodeAt(ctx, contract, blockNumber)
+	})
+}
+
+func (b *retryBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return retry(ctx, b.policy, func(int) ([]byte, error) {
+		return b.backend.CallContract(ctx, call, blockNumber)
+	})
+}
+
+func (b *retryBackend) PendingCallContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error) {
+	return retry(ctx, b.policy, func(int) ([]byte, error) {
+		if pending, ok := b.backend.(ethereum.PendingContractCaller); ok {
+			return pending.PendingCallContract(ctx, call)
+		}
+		return b.backend.CallContract(ctx, call, nil)
+	})
+}
+
+func (b *retryBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return retry(ctx, b.policy, func(int) (*types.Header, error) {
+		return b.backend.HeaderByNumber(ctx, number)
+	})
+}
+
+func (b *retryBackend) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return retry(ctx, b.policy, func(int) ([]byte, error) {
+		return b.backend.PendingCodeAt(ctx, account)
+	})
+}
+
+func (b *retryBackend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return retry(ctx, b.policy, func(int) (uint64, error) {
+		return b.backend.PendingNonceAt(ctx, account)
+	})
+}
+
+func (b *retryBackend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return retry(ctx, b.policy, func(int) (*big.Int, error) {
+		return b.backend.SuggestGasPrice(ctx)
+	})
+}
+
+func (b *retryBackend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return retry(ctx, b.policy, func(int) (*big.Int, error) {
+		return b.backend.SuggestGasTipCap(ctx)
+	})
+}
+
+func (b *retryBackend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return retry(ctx, b.policy, func(int) (uint64, error) {
+		return b.backend.EstimateGas(ctx, call)
+	})
+}
+
+// SendTransaction broadcasts the same signed transaction on every attempt. If
+// a previous attempt reached the node before failing, the node reports that
+// the transaction is already known, which is not an error.
+func (b *retryBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	_, err := retry(ctx, b.policy, func(attempt int) (struct{}, error) {
+		err := b.backend.SendTransaction(ctx, tx)
+		if err != nil && attempt > 1 && isKnownTransactionError(err) {
+			return struct{}{}, nil
+		}
+		return struct{}{}, err
+	})
+	return err
+}
+
+func isKnownTransactionError(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "already known") || strings.Contains(msg, "known transaction")
+}
+
+func (b *retryBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return retry(ctx, b.policy, func(int) ([]types.Log, error) {
+		return b.backend.FilterLogs(ctx, query)
+	})
+}
+
+func (b *retryBackend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return retry(ctx, b.policy, func(int) (ethereum.Subscription, error) {
+		return b.backend.SubscribeFilterLogs(ctx, query, ch)
+	})
+}
+
+func (b *retryBackend) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return retry(ctx, b.policy, func(int) (*types.Receipt, error) {
+		return b.backend.TransactionReceipt(ctx, txHash)
+	})
+}
+
+func (b *retryBackend) ChainID(ctx context.Context) (*big.Int, error) {
+	return retry(ctx, b.policy, func(int) (*big.Int, error) {
+		return b.backend.ChainID(ctx)
+	})
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"resenje.org/eas"
+	"resenje.org/eas/eastest"
+)
+
+func TestRetryPolicy_IsRetryable(t *testing.T) {
+	errCustom := errors.New("custom")
+
+	for _, tc := range []struct {
+		name   string
+		policy eas.RetryPolicy
+		err    error
+		want   bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "too many requests", err: eastest.HTTPError(http.StatusTooManyRequests, nil), want: true},
+		{name: "bad gateway", err: fmt.Errorf("wrapped: %w", eastest.HTTPError(http.StatusBadGateway, nil)), want: true},
+		{name: "bad request", err: eastest.HTTPError(http.StatusBadRequest, nil), want: false},
+		{name: "custom status code", policy: eas.RetryPolicy{RetryableStatusCodes: []int{http.StatusBadRequest}}, err: eastest.HTTPError(http.StatusBadRequest, nil), want: true},
+		{name: "revert", err: eastest.RevertError([]byte{1, 2, 3, 4}), want: false},
+		{name: "limit exceeded", err: rpcError{code: -32005}, want: true},
+		{name: "invalid params", err: rpcError{code: -32602}, want: false},
+		{name: "custom error code", policy: eas.RetryPolicy{RetryableErrorCodes: []int{-32602}}, err: rpcError{code: -32602}, want: true},
+		{name: "eof", err: io.ErrUnexpectedEOF, want: true},
+		{name: "canceled", err: context.Canceled, want: false},
+		{name: "unknown", err: errCustom, want: false},
+		{name: "custom", policy: eas.RetryPolicy{Retryable: func(err error) bool { return errors.Is(err, errCustom) }}, err: errCustom, want: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assertEqual(t, "retryable", tc.policy.IsRetryable(tc.err), tc.want)
+		})
+	}
+}
+
+type rpcError struct {
+	code int
+}
+
+func (e rpcError) Error() string  { return fmt.Sprintf("rpc error %v", e.code) }
+func (e rpcError) ErrorCode() int { return e.code }
+
+func TestClient_retry(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, &eastest.Options{Faults: true})
+
+	c, err := eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EAS, &eas.Options{
+		Backend: h.Backend(),
+		Retry: &eas.RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+		},
+	})
+	assertNilError(t, err)
+
+	t.Run("transient", func(t *testing.T) {
+		h.Faults.Reset()
+		h.Faults.Inject(eastest.Fault{
+			Method: "CallContract",
+			Times:  2,
+			Err:    eastest.HTTPError(http.StatusServiceUnavailable, nil),
+		})
+
+		version, err := c.SchemaRegistry.Version(ctx)
+		assertNilError(t, err)
+		assertEqual(t, "version", version, "1.0.0")
+		assertEqual(t, "calls", h.Faults.Calls("CallContract"), 3)
+	})
+
+	t.Run("max attempts", func(t *testing.T) {
+		h.Faults.Reset()
+		h.Faults.Inject(eastest.Fault{
+			Method: "CallContract",
+			Err:    eastest.HTTPError(http.StatusTooManyRequests, nil),
+		})
+
+		_, err := c.SchemaRegistry.Version(ctx)
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		assertEqual(t, "calls", h.Faults.Calls("CallContract"), 3)
+	})
+
+	t.Run("revert", func(t *testing.T) {
+		h.Faults.Reset()
+		h.Faults.Inject(eastest.Fault{
+			Method: "CallContract",
+			Times:  1,
+			Err:    eastest.RevertError(nil),
+		})
+
+		_, err := c.SchemaRegistry.Version(ctx)
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		assertEqual(t, "calls", h.Faults.Calls("CallContract"), 1)
+	})
+}
+
+func TestClient_retry_sendTransaction(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, nil)
+
+	// the first broadcast reaches the node, but the response is lost
+	backend := &lostResponseBackend{Backend: h.Simulated.Client()}
+
+	c, err := eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EAS, &eas.Options{
+		Backend: backend,
+		Retry: &eas.RetryPolicy{
+			InitialBackoff: time.Millisecond,
+		},
+	})
+	assertNilError(t, err)
+
+	tx, wait, err := c.SchemaRegistry.Register(ctx, "string message", [20]byte{}, true)
+	assertNilError(t, err)
+
+	h.Commit()
+
+	_, err = wait(ctx)
+	assertNilError(t, err)
+
+	assertEqual(t, "sent", backend.sentTransactions(), []*types.Transaction{tx, tx})
+
+	nonce, err := h.Simulated.Client().NonceAt(ctx, c.Address(), nil)
+	assertNilError(t, err)
+	assertEqual(t, "nonce", nonce, tx.Nonce()+1)
+}
+
+type lostResponseBackend struct {
+	eas.Backend
+
+	mu   sync.Mutex
+	sent []*types.Transaction
+}
+
+func (b *lostResponseBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	b.mu.Lock()
+	b.sent = append(b.sent, tx)
+	first := len(b.sent) == 1
+	b.mu.Unlock()
+
+	err := b.Backend.SendTransaction(ctx, tx)
+	if first && err == nil {
+		return eastest.HTTPError(http.StatusGatewayTimeout, nil)
+	}
+	return err
+}
+
+func (b *lostResponseBackend) sentTransactions() []*types.Transaction {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.sent
+}
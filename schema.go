@@ -80,6 +80,12 @@ func hasRedundantParentheses(s string) bool {
 }
 
 func encodeAttestationValues(values []any) ([]byte, error) {
+	if len(values) == 1 {
+		if data, ok := values[0].(EncodedData); ok {
+			return data, nil
+		}
+	}
+
 	var args abi.Arguments
 
 	for i, v := range values {
@@ -0,0 +1,392 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// EncodedData is attestation data that is already encoded according to the
+// schema. If it is passed as the only value to attestation methods, it is
+// used as is, without any further encoding.
+type EncodedData []byte
+
+// SchemaValue is a single decoded schema field.
+type SchemaValue struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value any    `json:"value"`
+}
+
+// ParseSchema parses schema definition, for example "uint256 id, string
+// name", into ABI arguments.
+func ParseSchema(schema string) (abi.Arguments, error) {
+	fields, err := parseSchemaFields(schema)
+	if err != nil {
+		return nil, err
+	}
+	args := make(abi.Arguments, 0, len(fields))
+	for _, f := range fields {
+		t, err := abi.NewType(f.Type, f.InternalType, f.Components)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", f.Name, err)
+		}
+		args = append(args, abi.Argument{
+			Name: f.Name,
+			Type: t,
+		})
+	}
+	return args, nil
+}
+
+// EncodeSchemaValues encodes values provided as a map of schema field names
+// to JSON compatible values into attestation data. Addresses, bytes and
+// fixed bytes are expected as hex strings and integers as numbers or decimal
+// or hex strings. Tuples are represented as maps and arrays as slices.
+func EncodeSchemaValues(schema string, values map[string]any) ([]byte, error) {
+	args, err := ParseSchema(schema)
+	if err != nil {
+		return nil, fmt.Errorf("parse schema: %w", err)
+	}
+	s := make([]any, 0, len(args))
+	for _, arg := range args {
+		v, ok := values[arg.Name]
+		if !ok {
+			return nil, fmt.Errorf("missing value for field %q", arg.Name)
+		}
+		gv, err := fromJSONValue(arg.Type, v)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", arg.Name, err)
+		}
+		s = append(s, gv)
+	}
+	data, err := args.Pack(s...)
+	if err != nil {
+		return nil, fmt.Errorf("pack abi: %w", err)
+	}
+	return data, nil
+}
+
+// DecodeSchemaValues decodes attestation data according to the schema into
+// JSON compatible values.
+func DecodeSchemaValues(schema string, data []byte) ([]SchemaValue, error) {
+	args, err := ParseSchema(schema)
+	if err != nil {
+		return nil, fmt.Errorf("parse schema: %w", err)
+	}
+	values, err := args.Unpack(data)
+	if err != nil {
+		return nil, fmt.Errorf("unpack abi: %w", err)
+	}
+	if len(values) != len(args) {
+		return nil, errors.New("unable to unpack all fields")
+	}
+	s := make([]SchemaValue, 0, len(args))
+	for i, arg := range args {
+		s = append(s, SchemaValue{
+			Name:  arg.Name,
+			Type:  arg.Type.String(),
+			Value: toJSONValue(arg.Type, reflect.ValueOf(values[i])),
+		})
+	}
+	return s, nil
+}
+
+func parseSchemaFields(schema string) ([]abi.ArgumentMarshaling, error) {
+	parts, err := splitSchemaFields(schema)
+	if err != nil {
+		return nil, err
+	}
+	fields := make([]abi.ArgumentMarshaling, 0, len(parts))
+	for _, p := range parts {
+		f, err := parseSchemaField(p)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+func parseSchemaField(s string) (abi.ArgumentMarshaling, error) {
+	s = strings.TrimSpace(s)
+	i := strings.LastIndexAny(s, " )]")
+	if i < 0 || s[i] != ' ' {
+		return abi.ArgumentMarshaling{}, fmt.Errorf("field %q without a name", s)
+	}
+	ty, name := strings.TrimSpace(s[:i]), strings.TrimSpace(s[i+1:])
+	if ty == "" || !isSchemaFieldName(name) {
+		return abi.ArgumentMarshaling{}, fmt.Errorf("invalid field %q", s)
+	}
+
+	if !strings.HasPrefix(ty, "(") {
+		return abi.ArgumentMarshaling{
+			Name: name,
+			Type: normalizeSchemaType(ty),
+		}, nil
+	}
+
+	end := strings.LastIndex(ty, ")")
+	if end < 0 {
+		return abi.ArgumentMarshaling{}, fmt.Errorf("field %q: unbalanced parentheses", name)
+	}
+	components, err := parseSchemaFields(ty[1:end])
+	if err != nil {
+		return abi.ArgumentMarshaling{}, fmt.Errorf("field %q: %w", name, err)
+	}
+	return abi.ArgumentMarshaling{
+		Name:       name,
+		Type:       "tuple" + ty[end+1:],
+		Components: components,
+	}, nil
+}
+
+func isSchemaFieldName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		switch {
+		case r == '_', r == '$':
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		case r >= '0' && r <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func splitSchemaFields(schema string) ([]string, error) {
+	var parts []string
+	depth := 0
+	start := 0
+	for i, r := range schema {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, errors.New("unbalanced parentheses")
+			}
+		case ',':
+			if depth == 0 {
+				parts = append(parts, schema[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, errors.New("unbalanced parentheses")
+	}
+	if last := schema[start:]; strings.TrimSpace(last) != "" || len(parts) > 0 {
+		parts = append(parts, last)
+	}
+	return parts, nil
+}
+
+func normalizeSchemaType(ty string) string {
+	base, suffix := ty, ""
+	if i := strings.Index(ty, "["); i >= 0 {
+		base, suffix = ty[:i], ty[i:]
+	}
+	switch base {
+	case "uint":
+		base = "uint256"
+	case "int":
+		base = "int256"
+	case "ipfsHash":
+		base = "bytes32"
+	}
+	return base + suffix
+}
+
+func fromJSONValue(t abi.Type, v any) (any, error) {
+	switch t.T {
+	case abi.AddressTy:
+		s, ok := v.(string)
+		if !ok || !common.IsHexAddress(s) {
+			return nil, fmt.Errorf("invalid address %v", v)
+		}
+		return common.HexToAddress(s), nil
+	case abi.StringTy:
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid string %v", v)
+		}
+		return s, nil
+	case abi.BoolTy:
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("invalid bool %v", v)
+		}
+		return b, nil
+	case abi.UintTy, abi.IntTy:
+		n, err := jsonBigInt(v)
+		if err != nil {
+			return nil, err
+		}
+		if !integerInRange(t, n) {
+			return nil, fmt.Errorf("integer %v out of range for %s", n, t)
+		}
+		rt := t.GetType()
+		switch rt.Kind() {
+		case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return reflect.ValueOf(n.Uint64()).Convert(rt).Interface(), nil
+		case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return reflect.ValueOf(n.Int64()).Convert(rt).Interface(), nil
+		}
+		return n, nil
+	case abi.BytesTy:
+		return jsonHexBytes(v)
+	case abi.FixedBytesTy:
+		b, err := jsonHexBytes(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) != t.Size {
+			return nil, fmt.Errorf("invalid length %v for %s", len(b), t)
+		}
+		a := reflect.New(t.GetType()).Elem()
+		reflect.Copy(a, reflect.ValueOf(b))
+		return a.Interface(), nil
+	case abi.SliceTy, abi.ArrayTy:
+		s, ok := v.([]any)
+		if !ok {
+			return nil, fmt.Errorf("invalid array %v", v)
+		}
+		var a reflect.Value
+		if t.T == abi.SliceTy {
+			a = reflect.MakeSlice(t.GetType(), len(s), len(s))
+		} else {
+			if len(s) != t.Size {
+				return nil, fmt.Errorf("invalid length %v for %s", len(s), t)
+			}
+			a = reflect.New(t.GetType()).Elem()
+		}
+		for i, e := range s {
+			ev, err := fromJSONValue(*t.Elem, e)
+			if err != nil {
+				return nil, fmt.Errorf("element %v: %w", i, err)
+			}
+			a.Index(i).Set(reflect.ValueOf(ev))
+		}
+		return a.Interface(), nil
+	case abi.TupleTy:
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("invalid tuple %v", v)
+		}
+		s := reflect.New(t.GetType()).Elem()
+		for i, name := range t.TupleRawNames {
+			e, ok := m[name]
+			if !ok {
+				return nil, fmt.Errorf("missing value for tuple field %q", name)
+			}
+			ev, err := fromJSONValue(*t.TupleElems[i], e)
+			if err != nil {
+				return nil, fmt.Errorf("tuple field %q: %w", name, err)
+			}
+			s.Field(i).Set(reflect.ValueOf(ev))
+		}
+		return s.Interface(), nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", t)
+}
+
+func toJSONValue(t abi.Type, v reflect.Value) any {
+	switch t.T {
+	case abi.AddressTy:
+		return v.Interface().(common.Address).Hex()
+	case abi.UintTy, abi.IntTy:
+		switch n := v.Interface().(type) {
+		case *big.Int:
+			return n
+		default:
+			if v.CanUint() {
+				return new(big.Int).SetUint64(v.Uint())
+			}
+			return big.NewInt(v.Int())
+		}
+	case abi.BytesTy:
+		return "0x" + hex.EncodeToString(v.Bytes())
+	case abi.FixedBytesTy:
+		b := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(b), v)
+		return "0x" + hex.EncodeToString(b)
+	case abi.SliceTy, abi.ArrayTy:
+		s := make([]any, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			s = append(s, toJSONValue(*t.Elem, v.Index(i)))
+		}
+		return s
+	case abi.TupleTy:
+		m := make(map[string]any, len(t.TupleRawNames))
+		for i, name := range t.TupleRawNames {
+			m[name] = toJSONValue(*t.TupleElems[i], v.Field(i))
+		}
+		return m
+	}
+	return v.Interface()
+}
+
+func integerInRange(t abi.Type, n *big.Int) bool {
+	if t.T == abi.UintTy {
+		return n.Sign() >= 0 && n.BitLen() <= t.Size
+	}
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(t.Size-1))
+	if n.Sign() < 0 {
+		return new(big.Int).Neg(n).Cmp(limit) <= 0
+	}
+	return n.Cmp(limit) < 0
+}
+
+func jsonBigInt(v any) (*big.Int, error) {
+	switch v := v.(type) {
+	case json.Number:
+		n, ok := new(big.Int).SetString(v.String(), 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid integer %v", v)
+		}
+		return n, nil
+	case float64:
+		if v != float64(int64(v)) {
+			return nil, fmt.Errorf("invalid integer %v", v)
+		}
+		return big.NewInt(int64(v)), nil
+	case string:
+		n, ok := new(big.Int).SetString(v, 0)
+		if !ok {
+			return nil, fmt.Errorf("invalid integer %q", v)
+		}
+		return n, nil
+	case *big.Int:
+		return new(big.Int).Set(v), nil
+	}
+	return nil, fmt.Errorf("invalid integer %v", v)
+}
+
+func jsonHexBytes(v any) ([]byte, error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid hex string %v", v)
+	}
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex string %q: %w", s, err)
+	}
+	return b, nil
+}
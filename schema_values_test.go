@@ -0,0 +1,210 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"resenje.org/eas"
+)
+
+func TestParseSchema(t *testing.T) {
+	for _, tc := range []struct {
+		schema string
+		want   []string
+	}{
+		{
+			schema: "string message",
+			want:   []string{"string message"},
+		},
+		{
+			schema: "uint256 id, bool valid, address[] owners",
+			want:   []string{"uint256 id", "bool valid", "address[] owners"},
+		},
+		{
+			schema: "uint id, ipfsHash cid, int8 delta",
+			want:   []string{"uint256 id", "bytes32 cid", "int8 delta"},
+		},
+		{
+			schema: "uint64 ID, (string key, string somethingStrange)[] Map, string Comment",
+			want:   []string{"uint64 ID", "(string,string)[] Map", "string Comment"},
+		},
+		{
+			schema: "(bytes32 id, (uint8 a, bool b)[2] pairs) record",
+			want:   []string{"(bytes32,(uint8,bool)[2]) record"},
+		},
+	} {
+		t.Run(tc.schema, func(t *testing.T) {
+			args, err := eas.ParseSchema(tc.schema)
+			assertNilError(t, err)
+
+			got := make([]string, 0, len(args))
+			for _, a := range args {
+				got = append(got, a.Type.String()+" "+a.Name)
+			}
+			assertEqual(t, "arguments", got, tc.want)
+		})
+	}
+}
+
+func TestParseSchema_invalid(t *testing.T) {
+	for _, schema := range []string{
+		"string",
+		"uint256 id, bool",
+		"(string key, string value",
+		"(string key) [",
+		"byte32 uid",
+	} {
+		t.Run(schema, func(t *testing.T) {
+			if _, err := eas.ParseSchema(schema); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestEncodeSchemaValues(t *testing.T) {
+	type KV struct {
+		Key   string
+		Value string `abi:"somethingStrange"`
+	}
+
+	type Schema struct {
+		ID      uint64
+		Owner   common.Address
+		Map     []KV
+		Hash    eas.UID
+		Payload []byte
+		Amount  *big.Int
+	}
+
+	want := Schema{
+		ID:    3,
+		Owner: common.HexToAddress("0x08752c431c3e38b12e94a0f195b166590e764831"),
+		Map: []KV{
+			{"k1", "v1"},
+			{"k2", "v2"},
+		},
+		Hash:    eas.HexDecodeUID("0x6fa753be53bb614388f8a8116b139df78dac74311d6f91d6b561ac8517032170"),
+		Payload: []byte{1, 2, 3},
+		Amount:  new(big.Int).Lsh(big.NewInt(1), 100),
+	}
+
+	schema := "uint64 ID, address Owner, (string Key, string somethingStrange)[] Map, bytes32 Hash, bytes Payload, uint256 Amount"
+
+	input := `{
+		"ID": 3,
+		"Owner": "0x08752c431c3e38B12e94A0F195B166590e764831",
+		"Map": [{"Key": "k1", "somethingStrange": "v1"}, {"Key": "k2", "somethingStrange": "v2"}],
+		"Hash": "0x6fa753be53bb614388f8a8116b139df78dac74311d6f91d6b561ac8517032170",
+		"Payload": "0x010203",
+		"Amount": "1267650600228229401496703205376"
+	}`
+
+	dec := json.NewDecoder(bytes.NewReader([]byte(input)))
+	dec.UseNumber()
+	var values map[string]any
+	assertNilError(t, dec.Decode(&values))
+
+	data, err := eas.EncodeSchemaValues(schema, values)
+	assertNilError(t, err)
+
+	var got Schema
+	assertNilError(t, eas.Attestation{Data: data}.ScanValues(&got.ID, &got.Owner, &got.Map, &got.Hash, &got.Payload, &got.Amount))
+	assertEqual(t, "values", got, want)
+
+	decoded, err := eas.DecodeSchemaValues(schema, data)
+	assertNilError(t, err)
+
+	b, err := json.Marshal(decoded)
+	assertNilError(t, err)
+
+	assertEqual(t, "json", string(b), `[{"name":"ID","type":"uint64","value":3},{"name":"Owner","type":"address","value":"0x08752c431c3e38B12e94A0F195B166590e764831"},{"name":"Map","type":"(string,string)[]","value":[{"Key":"k1","somethingStrange":"v1"},{"Key":"k2","somethingStrange":"v2"}]},{"name":"Hash","type":"bytes32","value":"0x6fa753be53bb614388f8a8116b139df78dac74311d6f91d6b561ac8517032170"},{"name":"Payload","type":"bytes","value":"0x010203"},{"name":"Amount","type":"uint256","value":1267650600228229401496703205376}]`)
+}
+
+func TestEncodeSchemaValues_invalid(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		schema string
+		values map[string]any
+	}{
+		{
+			name:   "missing field",
+			schema: "string message, bool valid",
+			values: map[string]any{"message": "hello"},
+		},
+		{
+			name:   "invalid address",
+			schema: "address owner",
+			values: map[string]any{"owner": "0x1234"},
+		},
+		{
+			name:   "uint overflow",
+			schema: "uint8 count",
+			values: map[string]any{"count": json.Number("256")},
+		},
+		{
+			name:   "negative uint",
+			schema: "uint64 count",
+			values: map[string]any{"count": json.Number("-1")},
+		},
+		{
+			name:   "int overflow",
+			schema: "int8 delta",
+			values: map[string]any{"delta": json.Number("128")},
+		},
+		{
+			name:   "fixed bytes length",
+			schema: "bytes32 hash",
+			values: map[string]any{"hash": "0x0102"},
+		},
+		{
+			name:   "array length",
+			schema: "bool[2] flags",
+			values: map[string]any{"flags": []any{true}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := eas.EncodeSchemaValues(tc.schema, tc.values); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestEASContract_Attest_encodedData(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	schema := "string message, int16 delta"
+	schemaUID := registerSchema(t, client, schema)
+
+	data, err := eas.EncodeSchemaValues(schema, map[string]any{
+		"message": "Hello!",
+		"delta":   json.Number("-42"),
+	})
+	assertNilError(t, err)
+
+	uid := attest(t, client, schemaUID, nil, eas.EncodedData(data))
+
+	a, err := client.EAS.GetAttestation(ctx, uid)
+	assertNilError(t, err)
+
+	assertEqual(t, "data", a.Data, data)
+
+	values, err := eas.DecodeSchemaValues(schema, a.Data)
+	assertNilError(t, err)
+
+	assertEqual(t, "values", values, []eas.SchemaValue{
+		{Name: "message", Type: "string", Value: "Hello!"},
+		{Name: "delta", Type: "int16", Value: big.NewInt(-42)},
+	})
+}
@@ -8,6 +8,7 @@ package eas
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -37,7 +38,10 @@ func newSchemaRecord(r *contracts.SchemaRecord) *SchemaRecord {
 type SchemaRegistryRegistered struct {
 	UID        UID
 	Registerer common.Address
-	Raw        types.Log
+	// Schema is the registered schema record, set only for events of
+	// SchemaRegistry contracts from version 1.2.
+	Schema *SchemaRecord
+	Raw    types.Log
 }
 
 func newSchemaRegistryRegistered(r *contracts.SchemaRegistryRegistered) *SchemaRegistryRegistered {
@@ -48,10 +52,59 @@ func newSchemaRegistryRegistered(r *contracts.SchemaRegistryRegistered) *SchemaR
 	}
 }
 
+// registeredSchemaABI defines the Registered event of SchemaRegistry
+// contracts from version 1.2 that includes the schema record.
+var registeredSchemaABI = func() *abi.ABI {
+	a, err := abi.JSON(strings.NewReader(`[{
+		"type": "event",
+		"name": "Registered",
+		"anonymous": false,
+		"inputs": [
+			{"name": "uid", "type": "bytes32", "indexed": true},
+			{"name": "registerer", "type": "address", "indexed": true},
+			{"name": "schema", "type": "tuple", "indexed": false, "components": [
+				{"name": "uid", "type": "bytes32"},
+				{"name": "resolver", "type": "address"},
+				{"name": "revocable", "type": "bool"},
+				{"name": "schema", "type": "string"}
+			]}
+		]
+	}]`))
+	if err != nil {
+		panic(err)
+	}
+	return &a
+}()
+
+var registeredSchemaContract = bind.NewBoundContract(common.Address{}, *registeredSchemaABI, nil, nil, nil)
+
+func parseRegisteredSchema(l types.Log) (*SchemaRegistryRegistered, error) {
+	var e struct {
+		Uid        [32]byte
+		Registerer common.Address
+		Schema     contracts.SchemaRecord
+	}
+	if err := registeredSchemaContract.UnpackLog(&e, "Registered", l); err != nil {
+		return nil, err
+	}
+	return &SchemaRegistryRegistered{
+		UID:        UID(e.Uid),
+		Registerer: e.Registerer,
+		Schema:     newSchemaRecord(&e.Schema),
+		Raw:        l,
+	}, nil
+}
+
 type SchemaRegistryContract struct {
 	client   *Client
+	address  common.Address
 	contract *contracts.SchemaRegistry
 	abi      *abi.ABI
+
+	// registeredEvent and parseRegistered are selected by the contract
+	// version, as the signature of the Registered event changed in 1.2.
+	registeredEvent abi.Event
+	parseRegistered func(types.Log) (*SchemaRegistryRegistered, error)
 }
 
 func newSchemaRegistryContract(ctx context.Context, client *Client) (*SchemaRegistryContract, error) {
@@ -76,20 +129,32 @@ func newSchemaRegistryContract(ctx context.Context, client *Client) (*SchemaRegi
 		return nil, fmt.Errorf("construct abi bindings: %w", err)
 	}
 
-	abi, err := contracts.EASMetaData.GetAbi()
+	abi, err := contracts.SchemaRegistryMetaData.GetAbi()
 	if err != nil {
 		return nil, fmt.Errorf("get abi: %w", err)
 	}
 
 	return &SchemaRegistryContract{
-		client:   client,
-		contract: contract,
-		abi:      abi,
+		client:          client,
+		address:         contractAddress,
+		contract:        contract,
+		abi:             abi,
+		registeredEvent: abi.Events["Registered"],
+		parseRegistered: newParseProxy(contract.ParseRegistered, newSchemaRegistryRegistered),
 	}, nil
 }
 
+// setVersion selects the Registered event of the contract version.
+func (c *SchemaRegistryContract) setVersion(v Version) {
+	if v.Compare(registeredSchemaVersion) < 0 {
+		return
+	}
+	c.registeredEvent = registeredSchemaABI.Events["Registered"]
+	c.parseRegistered = parseRegisteredSchema
+}
+
 func (c *SchemaRegistryContract) parseError(err error) error {
-	return unpackError(err, c.abi)
+	return unpackError(err, c.client.errorABIsFor(c.abi, common.Address{})...)
 }
 
 func (c *SchemaRegistryContract) Version(ctx context.Context) (string, error) {
@@ -101,6 +166,12 @@ func (c *SchemaRegistryContract) Version(ctx context.Context) (string, error) {
 }
 
 func (c *SchemaRegistryContract) Register(ctx context.Context, schema string, resolver common.Address, revocable bool) (*types.Transaction, WaitTx[SchemaRegistryRegistered], error) {
+	if c.client.options.Simulate {
+		if _, err := c.SimulateRegister(ctx, schema, resolver, revocable); err != nil {
+			return nil, nil, fmt.Errorf("simulate register: %w", err)
+		}
+	}
+
 	txOpts, err := c.client.newTxOpts(ctx)
 	if err != nil {
 		return nil, nil, fmt.Errorf("construct transaction options: %w", err)
@@ -111,37 +182,74 @@ func (c *SchemaRegistryContract) Register(ctx context.Context, schema string, re
 		return nil, nil, fmt.Errorf("call register contract method: %w", c.parseError(err))
 	}
 
-	return tx, newWaitTx(tx, c.client, newParseProxy(c.contract.ParseRegistered, newSchemaRegistryRegistered)), nil
+	return tx, c.WaitRegister(tx), nil
 }
 
-func (c *SchemaRegistryContract) GetSchema(ctx context.Context, uid UID) (*SchemaRecord, error) {
-	r, err := c.contract.GetSchema(&bind.CallOpts{Context: ctx}, uid)
-	if err != nil {
-		return nil, c.parseError(err)
-	}
-	return newSchemaRecord(&r), nil
+// RegisterCall returns the call of the schema registration that can be signed
+// and sent as a transaction by any account.
+func (c *SchemaRegistryContract) RegisterCall(schema string, resolver common.Address, revocable bool) (*Call, error) {
+	return c.client.newCall(c.address, c.abi, nil, "register", schema, resolver, revocable)
 }
 
-type schemaRegistryRegisteredIterator struct {
-	contracts.SchemaRegistryRegisteredIterator
+// WaitRegister returns the function that waits for the schema registration
+// transaction to be mined, regardless of the account that sent it.
+func (c *SchemaRegistryContract) WaitRegister(tx *types.Transaction) WaitTx[SchemaRegistryRegistered] {
+	return newWaitTx(tx, c.client, c.address, c.registeredEvent, c.parseRegistered)
 }
 
-func (i *schemaRegistryRegisteredIterator) Value() *SchemaRegistryRegistered {
-	return newSchemaRegistryRegistered(i.Event)
+// SimulateRegister executes the schema registration against the pending state
+// without sending a transaction and returns the UID of the schema that would
+// be registered.
+func (c *SchemaRegistryContract) SimulateRegister(ctx context.Context, schema string, resolver common.Address, revocable bool) (UID, error) {
+	output, err := c.client.simulate(ctx, c.address, c.abi, nil, "register", schema, resolver, revocable)
+	if err != nil {
+		return UID{}, c.parseError(err)
+	}
+
+	return *abi.ConvertType(output[0], new(UID)).(*UID), nil
 }
 
-func (c *SchemaRegistryContract) FilterRegistered(ctx context.Context, start uint64, end *uint64, uids []UID) (Iterator[*SchemaRegistryRegistered], error) {
-	it, err := c.contract.FilterRegistered(&bind.FilterOpts{Start: start, End: end, Context: ctx}, castUIDSlice(uids))
+// EstimateRegister estimates the gas and fee of the schema registration
+// transaction.
+func (c *SchemaRegistryContract) EstimateRegister(ctx context.Context, schema string, resolver common.Address, revocable bool) (*Estimate, error) {
+	e, err := c.client.estimate(ctx, c.address, c.abi, nil, "register", schema, resolver, revocable)
 	if err != nil {
 		return nil, c.parseError(err)
 	}
-	return &schemaRegistryRegisteredIterator{*it}, nil
+	return e, nil
 }
 
-func (c *SchemaRegistryContract) WatchRegistered(ctx context.Context, start *uint64, sink chan<- *SchemaRegistryRegistered, uids []UID) (event.Subscription, error) {
-	s, err := c.contract.WatchRegistered(&bind.WatchOpts{Start: start, Context: ctx}, newChanProxy(ctx, sink, newSchemaRegistryRegistered), castUIDSlice(uids))
+func (c *SchemaRegistryContract) GetSchema(ctx context.Context, uid UID) (*SchemaRecord, error) {
+	r, err := c.contract.GetSchema(&bind.CallOpts{Context: ctx}, uid)
 	if err != nil {
 		return nil, c.parseError(err)
 	}
-	return s, nil
+	return newSchemaRecord(&r), nil
+}
+
+// GetSchemas returns schemas with uids in as few requests as possible.
+// Errors of individual calls are set in results.
+func (c *SchemaRegistryContract) GetSchemas(ctx context.Context, uids []UID) ([]BatchResult[*SchemaRecord], error) {
+	return batch(ctx, c.client, c.address, c.abi, "getSchema", uidArgs(uids), func(out []any) *SchemaRecord {
+		r := convertValue[contracts.SchemaRecord](out[0])
+		return newSchemaRecord(&r)
+	})
+}
+
+func (c *SchemaRegistryContract) FilterRegistered(ctx context.Context, start uint64, end *uint64, uids []UID) (Iterator[*SchemaRegistryRegistered], error) {
+	return newFilterIterator(ctx, c.client, c.address, c.registeredEvent, start, end,
+		[][]any{filterRule(castUIDSlice(uids))},
+		c.parseRegistered)
+}
+
+func (c *SchemaRegistryContract) WatchRegistered(ctx context.Context, start *uint64, sink chan<- *SchemaRegistryRegistered, uids []UID) (event.Subscription, error) {
+	return watchLogs(ctx, c.client, c.address, c.registeredEvent, start,
+		[][]any{filterRule(castUIDSlice(uids))},
+		c.parseRegistered, sink)
+}
+
+func (c *SchemaRegistryContract) StreamRegistered(ctx context.Context, o *StreamOptions, sink chan<- StreamEvent[*SchemaRegistryRegistered], uids []UID) (event.Subscription, error) {
+	return streamLogs(ctx, c.client, c.address, c.registeredEvent, o,
+		[][]any{filterRule(castUIDSlice(uids))},
+		c.parseRegistered, sink)
 }
@@ -245,3 +245,16 @@ func registerSchema(t *testing.T, client *Client, schema string) eas.UID {
 
 	return r.UID
 }
+
+func TestSchemaRegistryContract_SimulateRegister(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	uid, err := client.SchemaRegistry.SimulateRegister(ctx, "string message", common.Address{}, true)
+	assertNilError(t, err)
+
+	assertEqual(t, "uid", uid, registerSchema(t, client, "string message"))
+
+	_, err = client.SchemaRegistry.SimulateRegister(ctx, "string message", common.Address{}, true)
+	assertErrorIs(t, err, eas.ErrAlreadyExists)
+}
@@ -0,0 +1,316 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// defaultStreamReorgDepth is the number of blocks checked for reorgs if
+// StreamOptions.ReorgDepth is not set.
+const defaultStreamReorgDepth = 64
+
+// errStreamReorg is returned when the chain changes while blocks are queried.
+var errStreamReorg = errors.New("chain reorganized during query")
+
+// StreamEventType tells if the event is added to the chain or removed from it
+// by a reorg.
+type StreamEventType int
+
+const (
+	// StreamEventAdded is sent for events in new blocks.
+	StreamEventAdded StreamEventType = iota + 1
+	// StreamEventRemoved is sent for previously added events whose blocks
+	// are replaced by a reorg.
+	StreamEventRemoved
+)
+
+func (t StreamEventType) String() string {
+	switch t {
+	case StreamEventAdded:
+		return "added"
+	case StreamEventRemoved:
+		return "removed"
+	}
+	return fmt.Sprintf("StreamEventType(%d)", int(t))
+}
+
+// StreamEvent is a contract event that is added to the chain or removed from
+// it.
+type StreamEvent[T any] struct {
+	Type  StreamEventType
+	Value T
+	// Log is the log of the event with the number and the hash of the block
+	// in which it was added.
+	Log types.Log
+}
+
+// StreamOptions configure Stream methods.
+type StreamOptions struct {
+	// Start is the first block of streamed events. If nil, only events from
+	// new blocks are streamed.
+	Start *uint64
+	// Confirmations is the number of blocks that have to be added after the
+	// block of the event before the event is sent.
+	Confirmations uint64
+	// ReorgDepth is the number of latest blocks whose hashes are tracked to
+	// detect reorgs. Default is 64.
+	ReorgDepth uint64
+}
+
+// logStream sends added and removed events of a contract event to the sink.
+// It periodically queries logs of new blocks and checks hashes of tracked
+// blocks to detect reorgs, sending previously added events from replaced
+// blocks as removed, in reverse order.
+type logStream[T any] struct {
+	client *Client
+	query  ethereum.FilterQuery
+	parse  func(types.Log) (T, error)
+	sink   chan<- StreamEvent[T]
+
+	confirmations uint64
+	reorgDepth    uint64
+	pollInterval  time.Duration
+	// from is the first block that is not queried.
+	from uint64
+	// blocks are tracked blocks in ascending order.
+	blocks []streamBlock[T]
+}
+
+type streamBlock[T any] struct {
+	number uint64
+	hash   common.Hash
+	events []StreamEvent[T]
+}
+
+// streamLogs sends added and removed events of the contract event to the
+// sink. Rules are values of indexed event arguments, in the order of
+// arguments.
+func streamLogs[T any](ctx context.Context, client *Client, address common.Address, ev abi.Event, o *StreamOptions, rules [][]any, parse func(types.Log) (T, error), sink chan<- StreamEvent[T]) (event.Subscription, error) {
+	query, err := eventQuery(address, ev, rules)
+	if err != nil {
+		return nil, err
+	}
+	return streamQuery(ctx, client, query, o, parse, sink)
+}
+
+// streamQuery sends added and removed events of logs of the query, without
+// the block range, to the sink.
+func streamQuery[T any](ctx context.Context, client *Client, query ethereum.FilterQuery, o *StreamOptions, parse func(types.Log) (T, error), sink chan<- StreamEvent[T]) (event.Subscription, error) {
+	if o == nil {
+		o = new(StreamOptions)
+	}
+
+	reorgDepth := o.ReorgDepth
+	if reorgDepth == 0 {
+		reorgDepth = defaultStreamReorgDepth
+	}
+
+	pollInterval := client.options.WatchPollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultWatchPollInterval
+	}
+
+	var from uint64
+	if o.Start != nil {
+		from = *o.Start
+	} else {
+		header, err := client.backend.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, fmt.Errorf("get latest block: %w", err)
+		}
+		if latest := header.Number.Uint64(); latest >= o.Confirmations {
+			from = latest - o.Confirmations + 1
+		}
+	}
+
+	s := &logStream[T]{
+		client:        client,
+		query:         query,
+		parse:         parse,
+		sink:          sink,
+		confirmations: o.Confirmations,
+		reorgDepth:    reorgDepth,
+		pollInterval:  pollInterval,
+		from:          from,
+	}
+
+	return newSubscription(ctx, s.run), nil
+}
+
+// run updates the stream until the context is done or a fatal error occurs.
+func (s *logStream[T]) run(ctx context.Context) error {
+	for {
+		err := s.update(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		var fatal *fatalWatchError
+		if errors.As(err, &fatal) {
+			return fatal.err
+		}
+
+		if !waitInterval(ctx, s.pollInterval) {
+			return nil
+		}
+	}
+}
+
+// update removes events of replaced blocks and adds events of new confirmed
+// blocks.
+func (s *logStream[T]) update(ctx context.Context) error {
+	header, err := s.client.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("get latest block: %w", err)
+	}
+
+	if err := s.rollback(ctx); err != nil {
+		return err
+	}
+
+	latest := header.Number.Uint64()
+	if latest < s.confirmations {
+		return nil
+	}
+	target := latest - s.confirmations
+	if s.from > target {
+		return nil
+	}
+
+	it, err := newQueryIterator(ctx, s.client, s.query, s.from, &target, func(l types.Log) (types.Log, error) {
+		return l, nil
+	})
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	var logs []types.Log
+	for it.Next() {
+		logs = append(logs, it.Value())
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	blocks, err := s.headers(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	events := make([]StreamEvent[T], 0, len(logs))
+	for _, l := range logs {
+		if l.Removed {
+			continue
+		}
+		v, err := s.parse(l)
+		if err != nil {
+			return &fatalWatchError{err: fmt.Errorf("parse log: %w", err)}
+		}
+		e := StreamEvent[T]{Type: StreamEventAdded, Value: v, Log: l}
+		events = append(events, e)
+
+		if len(blocks) == 0 || l.BlockNumber < blocks[0].number {
+			continue
+		}
+		b := &blocks[l.BlockNumber-blocks[0].number]
+		if b.hash != l.BlockHash {
+			return errStreamReorg
+		}
+		b.events = append(b.events, e)
+	}
+
+	for _, e := range events {
+		if err := s.send(ctx, e); err != nil {
+			return err
+		}
+	}
+
+	s.blocks = append(s.blocks, blocks...)
+	if len(s.blocks) > int(s.reorgDepth) {
+		s.blocks = s.blocks[len(s.blocks)-int(s.reorgDepth):]
+	}
+	s.from = target + 1
+	return nil
+}
+
+// headers returns blocks from the first queried block to the target block
+// that are tracked, verifying that they form a chain with tracked blocks.
+func (s *logStream[T]) headers(ctx context.Context, target uint64) ([]streamBlock[T], error) {
+	first := s.from
+	if target >= s.reorgDepth && target-s.reorgDepth+1 > first {
+		first = target - s.reorgDepth + 1
+	}
+
+	var parent *streamBlock[T]
+	if l := len(s.blocks); l > 0 && s.blocks[l-1].number+1 == first {
+		parent = &s.blocks[l-1]
+	}
+
+	blocks := make([]streamBlock[T], 0, target-first+1)
+	for n := first; n <= target; n++ {
+		header, err := s.client.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(n))
+		if err != nil {
+			return nil, fmt.Errorf("get block %v: %w", n, err)
+		}
+		if parent != nil && header.ParentHash != parent.hash {
+			return nil, errStreamReorg
+		}
+		blocks = append(blocks, streamBlock[T]{number: n, hash: header.Hash()})
+		parent = &blocks[len(blocks)-1]
+	}
+	return blocks, nil
+}
+
+// rollback removes tracked blocks that are replaced by a reorg and sends
+// their events as removed.
+func (s *logStream[T]) rollback(ctx context.Context) error {
+	for len(s.blocks) > 0 {
+		b := s.blocks[len(s.blocks)-1]
+
+		header, err := s.client.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(b.number))
+		switch {
+		case errors.Is(err, ethereum.NotFound):
+			// the chain is shorter than the tracked block
+		case err != nil:
+			return fmt.Errorf("get block %v: %w", b.number, err)
+		case header.Hash() == b.hash:
+			return nil
+		}
+
+		for i := len(b.events) - 1; i >= 0; i-- {
+			e := b.events[i]
+			e.Type = StreamEventRemoved
+			e.Log.Removed = true
+			if err := s.send(ctx, e); err != nil {
+				return err
+			}
+		}
+
+		s.blocks = s.blocks[:len(s.blocks)-1]
+		s.from = b.number
+	}
+	return nil
+}
+
+func (s *logStream[T]) send(ctx context.Context, e StreamEvent[T]) error {
+	select {
+	case s.sink <- e:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"resenje.org/eas"
+	"resenje.org/eas/eastest"
+)
+
+func TestEASContract_StreamAttested(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, &eastest.Options{AutoCommit: true, Faults: true})
+
+	_, wait, err := h.Client().SchemaRegistry.Register(ctx, "string message", [20]byte{}, true)
+	assertNilError(t, err)
+	r, err := wait(ctx)
+	assertNilError(t, err)
+	schemaUID := r.UID
+
+	c, err := eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EAS, &eas.Options{
+		Backend:           h.Backend(),
+		WatchPollInterval: 10 * time.Millisecond,
+	})
+	assertNilError(t, err)
+
+	attest := func(t *testing.T) *eas.EASAttested {
+		t.Helper()
+
+		_, wait, err := c.EAS.Attest(ctx, schemaUID, nil, "Hello!")
+		assertNilError(t, err)
+		r, err := wait(ctx)
+		assertNilError(t, err)
+		return r
+	}
+
+	receive := func(t *testing.T, sink <-chan eas.StreamEvent[*eas.EASAttested]) eas.StreamEvent[*eas.EASAttested] {
+		t.Helper()
+
+		select {
+		case e := <-sink:
+			return e
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout")
+		}
+		return eas.StreamEvent[*eas.EASAttested]{}
+	}
+
+	assertNoEvent := func(t *testing.T, sink <-chan eas.StreamEvent[*eas.EASAttested]) {
+		t.Helper()
+
+		select {
+		case e := <-sink:
+			t.Fatalf("unexpected %s event %s", e.Type, e.Value.UID)
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	t.Run("confirmations", func(t *testing.T) {
+		sink := make(chan eas.StreamEvent[*eas.EASAttested])
+		sub, err := c.EAS.StreamAttested(ctx, &eas.StreamOptions{Confirmations: 2}, sink, nil, nil, nil)
+		assertNilError(t, err)
+		defer sub.Unsubscribe()
+
+		a := attest(t)
+
+		h.Commit()
+		assertNoEvent(t, sink)
+
+		h.Commit()
+		e := receive(t, sink)
+		assertEqual(t, "type", e.Type, eas.StreamEventAdded)
+		assertEqual(t, "uid", e.Value.UID, a.UID)
+		assertEqual(t, "block hash", e.Log.BlockHash, a.Raw.BlockHash)
+	})
+
+	t.Run("reorg", func(t *testing.T) {
+		sink := make(chan eas.StreamEvent[*eas.EASAttested])
+		sub, err := c.EAS.StreamAttested(ctx, nil, sink, nil, nil, []eas.UID{schemaUID})
+		assertNilError(t, err)
+		defer sub.Unsubscribe()
+
+		a := attest(t)
+
+		e := receive(t, sink)
+		assertEqual(t, "type", e.Type, eas.StreamEventAdded)
+		assertEqual(t, "uid", e.Value.UID, a.UID)
+
+		assertNilError(t, h.Faults.Reorg(ctx, 2))
+
+		e = receive(t, sink)
+		assertEqual(t, "type", e.Type, eas.StreamEventRemoved)
+		assertEqual(t, "uid", e.Value.UID, a.UID)
+		assertEqual(t, "block hash", e.Log.BlockHash, a.Raw.BlockHash)
+		assertEqual(t, "removed", e.Log.Removed, true)
+
+		// the transaction is included in a new block, with a different uid
+		// as it depends on the block time
+		e = receive(t, sink)
+		assertEqual(t, "type", e.Type, eas.StreamEventAdded)
+		assertEqual(t, "tx hash", e.Log.TxHash, a.Raw.TxHash)
+		if e.Log.BlockHash == a.Raw.BlockHash {
+			t.Error("event is not in a new block")
+		}
+
+		assertNoEvent(t, sink)
+	})
+}
@@ -0,0 +1,220 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// ErrUnsupportedVersion is returned by NewClient if EAS or SchemaRegistry
+// contracts have a version that is not supported by the SDK.
+var ErrUnsupportedVersion = errors.New("unsupported contract version")
+
+// VersionError is returned if the version of a contract is not supported by
+// the SDK or does not support the requested functionality. It matches
+// ErrUnsupportedVersion with errors.Is.
+type VersionError struct {
+	// Contract is the name of the contract, such as "eas".
+	Contract string
+	Version  Version
+	// Reason describes versions that are required.
+	Reason string
+}
+
+func (e *VersionError) Error() string {
+	return fmt.Sprintf("%v: %s contract version %s: %s", ErrUnsupportedVersion, e.Contract, e.Version, e.Reason)
+}
+
+func (e *VersionError) Unwrap() error {
+	return ErrUnsupportedVersion
+}
+
+// Version is a semantic version of a contract.
+type Version struct {
+	Major uint64
+	Minor uint64
+	Patch uint64
+}
+
+// Range of supported EAS and SchemaRegistry contract versions. Contracts from
+// version 0.26 up to 1.3 have the same interface for all functions used by the
+// SDK, while the Registered event of SchemaRegistry contracts has a different
+// signature from version 1.2. Delegated requests through EIP712Proxy are
+// supported only with EAS contracts from version 1.0 and proxy contracts from
+// version 1.3, with the attester in the signed request.
+var (
+	MinSupportedVersion = Version{Major: 0, Minor: 26}
+	MaxSupportedVersion = Version{Major: 1, Minor: 3, Patch: ^uint64(0)}
+)
+
+var (
+	// registeredSchemaVersion is the first SchemaRegistry version with the
+	// schema record in the Registered event.
+	registeredSchemaVersion = Version{Major: 1, Minor: 2}
+	// minProxyEASVersion is the first EAS version with EIP712Proxy contracts.
+	minProxyEASVersion = Version{Major: 1}
+	// minProxyVersion is the first EIP712Proxy version with signatures that
+	// match the ones created by the SDK.
+	minProxyVersion = Version{Major: 1, Minor: 3}
+)
+
+// ParseVersion parses versions in the major.minor.patch format returned by
+// contracts. The patch number is optional, as some deployments return only
+// major and minor numbers.
+func ParseVersion(s string) (Version, error) {
+	parts := strings.Split(strings.TrimPrefix(s, "v"), ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return Version{}, fmt.Errorf("invalid version %q", s)
+	}
+
+	var numbers [3]uint64
+	for i, p := range parts {
+		n, err := strconv.ParseUint(p, 10, 64)
+		if err != nil {
+			return Version{}, fmt.Errorf("invalid version %q", s)
+		}
+		numbers[i] = n
+	}
+
+	return Version{
+		Major: numbers[0],
+		Minor: numbers[1],
+		Patch: numbers[2],
+	}, nil
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// Compare returns -1, 0 or 1 if the version is lower, equal or greater than
+// the other version.
+func (v Version) Compare(o Version) int {
+	for _, d := range [][2]uint64{{v.Major, o.Major}, {v.Minor, o.Minor}, {v.Patch, o.Patch}} {
+		switch {
+		case d[0] < d[1]:
+			return -1
+		case d[0] > d[1]:
+			return 1
+		}
+	}
+	return 0
+}
+
+// IsSupported returns true if the version is in the range of versions
+// supported by the SDK.
+func (v Version) IsSupported() bool {
+	return v.Compare(MinSupportedVersion) >= 0 && v.Compare(MaxSupportedVersion) <= 0
+}
+
+// checkVersion parses the contract version and checks if it is supported.
+func checkVersion(contract, s string) (Version, error) {
+	v, err := ParseVersion(s)
+	if err != nil {
+		return Version{}, fmt.Errorf("%w: %s contract: %w", ErrUnsupportedVersion, contract, err)
+	}
+	if !v.IsSupported() {
+		return Version{}, &VersionError{
+			Contract: contract,
+			Version:  v,
+			Reason:   fmt.Sprintf("supported versions are from %s up to %d.%d", MinSupportedVersion, MaxSupportedVersion.Major, MaxSupportedVersion.Minor),
+		}
+	}
+	return v, nil
+}
+
+// checkProxyVersion checks if the EAS contract and the EIP712Proxy contract
+// with the version support delegated requests created by the SDK. The EAS
+// version is zero if the version check is skipped.
+func checkProxyVersion(easVersion Version, s string) error {
+	if easVersion != (Version{}) && easVersion.Compare(minProxyEASVersion) < 0 {
+		return &VersionError{
+			Contract: "eas",
+			Version:  easVersion,
+			Reason:   fmt.Sprintf("delegated requests through eip712 proxy require version %s or newer", minProxyEASVersion),
+		}
+	}
+	v, err := ParseVersion(s)
+	if err != nil {
+		return fmt.Errorf("%w: eip712 proxy contract: %w", ErrUnsupportedVersion, err)
+	}
+	if v.Compare(minProxyVersion) < 0 || v.Compare(MaxSupportedVersion) > 0 {
+		return &VersionError{
+			Contract: "eip712 proxy",
+			Version:  v,
+			Reason:   fmt.Sprintf("supported versions are from %s up to %d.%d", minProxyVersion, MaxSupportedVersion.Major, MaxSupportedVersion.Minor),
+		}
+	}
+	return nil
+}
+
+// legacyVersionABI defines the VERSION constant of contracts before version
+// 1.0 that do not have the version function.
+var legacyVersionABI = func() *abi.ABI {
+	a, err := abi.JSON(strings.NewReader(`[
+		{"type": "function", "name": "VERSION", "stateMutability": "view", "inputs": [], "outputs": [{"name": "", "type": "string"}]}
+	]`))
+	if err != nil {
+		panic(err)
+	}
+	return &a
+}()
+
+// versionOf returns the contract version with the version function, or from
+// the VERSION constant of contracts before version 1.0 if the call of the
+// version function reverts.
+func (c *Client) versionOf(ctx context.Context, contract common.Address, version func(context.Context) (string, error)) (string, error) {
+	v, err := version(ctx)
+	if err == nil {
+		return v, nil
+	}
+	if !isRevertError(err) {
+		return "", err
+	}
+	v, legacyErr := c.legacyVersion(ctx, contract)
+	if legacyErr != nil {
+		return "", err
+	}
+	return v, nil
+}
+
+// isRevertError reports if the error is returned for a reverted execution.
+func isRevertError(err error) bool {
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) && dataErr.ErrorData() != nil {
+		return true
+	}
+	return strings.Contains(err.Error(), "execution reverted")
+}
+
+// legacyVersion returns the value of the VERSION constant of the contract.
+func (c *Client) legacyVersion(ctx context.Context, contract common.Address) (string, error) {
+	data, err := legacyVersionABI.Pack("VERSION")
+	if err != nil {
+		return "", fmt.Errorf("pack arguments: %w", err)
+	}
+	out, err := c.backend.CallContract(ctx, ethereum.CallMsg{
+		To:   &contract,
+		Data: data,
+	}, nil)
+	if err != nil {
+		return "", err
+	}
+	values, err := legacyVersionABI.Unpack("VERSION", out)
+	if err != nil {
+		return "", fmt.Errorf("unpack result: %w", err)
+	}
+	return convertValue[string](values[0]), nil
+}
@@ -0,0 +1,274 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"resenje.org/eas"
+	"resenje.org/eas/deployments"
+	"resenje.org/eas/eastest"
+)
+
+func TestParseVersion(t *testing.T) {
+	for _, tc := range []struct {
+		s         string
+		want      eas.Version
+		err       bool
+		supported bool
+	}{
+		{s: "1.0.0", want: eas.Version{Major: 1}, supported: true},
+		{s: "1.3.0", want: eas.Version{Major: 1, Minor: 3}, supported: true},
+		{s: "1.3.1", want: eas.Version{Major: 1, Minor: 3, Patch: 1}, supported: true},
+		{s: "1.4.0", want: eas.Version{Major: 1, Minor: 4}},
+		{s: "v1.2.3", want: eas.Version{Major: 1, Minor: 2, Patch: 3}, supported: true},
+		{s: "0.26", want: eas.Version{Minor: 26}, supported: true},
+		{s: "0.25.1", want: eas.Version{Minor: 25, Patch: 1}},
+		{s: "2.0.0", want: eas.Version{Major: 2}},
+		{s: "1", err: true},
+		{s: "1.0.0.0", err: true},
+		{s: "1.x.0", err: true},
+		{s: "", err: true},
+	} {
+		t.Run(tc.s, func(t *testing.T) {
+			got, err := eas.ParseVersion(tc.s)
+			if tc.err {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			assertNilError(t, err)
+			assertEqual(t, "version", got, tc.want)
+			assertEqual(t, "supported", got.IsSupported(), tc.supported)
+		})
+	}
+}
+
+func TestVersion_Compare(t *testing.T) {
+	v := eas.Version{Major: 1, Minor: 2, Patch: 3}
+
+	assertEqual(t, "equal", v.Compare(eas.Version{Major: 1, Minor: 2, Patch: 3}), 0)
+	assertEqual(t, "lower patch", v.Compare(eas.Version{Major: 1, Minor: 2, Patch: 4}), -1)
+	assertEqual(t, "greater minor", v.Compare(eas.Version{Major: 1, Minor: 1, Patch: 9}), 1)
+	assertEqual(t, "lower major", v.Compare(eas.Version{Major: 2}), -1)
+	assertEqual(t, "string", v.String(), "1.2.3")
+}
+
+func TestVersion_deployments(t *testing.T) {
+	for _, d := range deployments.All() {
+		v, err := eas.ParseVersion(d.Version)
+		assertNilError(t, err)
+		if !v.IsSupported() {
+			t.Errorf("%s: unsupported version %s", d.Name, v)
+		}
+	}
+}
+
+func TestNewClient_version(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, nil)
+
+	assertEqual(t, "eas version", h.Client().EASVersion(), eas.Version{Major: 1})
+	assertEqual(t, "schema registry version", h.Client().SchemaRegistryVersion(), eas.Version{Major: 1})
+
+	// token contract without the version function
+	token := h.DeployToken(t, map[common.Address]*big.Int{})
+
+	_, err := eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, token, &eas.Options{
+		Backend:                       h.Backend(),
+		SchemaRegistryContractAddress: h.Addresses.SchemaRegistry,
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	c, err := eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, token, &eas.Options{
+		Backend:                       h.Backend(),
+		SchemaRegistryContractAddress: h.Addresses.SchemaRegistry,
+		SkipVersionCheck:              true,
+	})
+	assertNilError(t, err)
+	assertEqual(t, "eas version", c.EASVersion(), eas.Version{})
+
+	// proxy contract with the supported version in place of the eas contract
+	_, err = eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EIP712Proxy, &eas.Options{
+		Backend:                       h.Backend(),
+		SchemaRegistryContractAddress: h.Addresses.SchemaRegistry,
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestNewClient_legacyVersion(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, nil)
+
+	// eas contract before version 1.0 with the VERSION constant
+	backend := &versionBackend{
+		Backend: h.Backend(),
+		address: h.Addresses.EAS,
+		legacy:  "0.26",
+	}
+
+	c, err := eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EAS, &eas.Options{
+		Backend: backend,
+	})
+	assertNilError(t, err)
+	assertEqual(t, "eas version", c.EASVersion(), eas.Version{Minor: 26})
+
+	_, err = eas.NewProxyContract(ctx, c, h.Addresses.EIP712Proxy)
+	assertErrorIs(t, err, eas.ErrUnsupportedVersion)
+	var versionErr *eas.VersionError
+	if !errors.As(err, &versionErr) {
+		t.Fatalf("got error %T, want *eas.VersionError", err)
+	}
+	assertEqual(t, "contract", versionErr.Contract, "eas")
+	assertEqual(t, "version", versionErr.Version, eas.Version{Minor: 26})
+
+	// unsupported proxy version
+	backend = &versionBackend{
+		Backend: h.Backend(),
+		address: h.Addresses.EIP712Proxy,
+		version: "1.2.0",
+	}
+	c, err = eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EAS, &eas.Options{
+		Backend: backend,
+	})
+	assertNilError(t, err)
+
+	_, err = eas.NewProxyContract(ctx, c, h.Addresses.EIP712Proxy)
+	assertErrorIs(t, err, eas.ErrUnsupportedVersion)
+}
+
+func TestSchemaRegistryContract_FilterRegistered_version(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, nil)
+
+	schemaType, err := abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "uid", Type: "bytes32"},
+		{Name: "resolver", Type: "address"},
+		{Name: "revocable", Type: "bool"},
+		{Name: "schema", Type: "string"},
+	})
+	assertNilError(t, err)
+
+	uid := eas.HexDecodeUID("0x2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a2a")
+	registerer := h.Accounts[0].Client.Address()
+	resolver := common.HexToAddress("0x1234")
+	data, err := abi.Arguments{{Type: schemaType}}.Pack(struct {
+		Uid       [32]byte
+		Resolver  common.Address
+		Revocable bool
+		Schema    string
+	}{
+		Uid:       uid,
+		Resolver:  resolver,
+		Revocable: true,
+		Schema:    "string message",
+	})
+	assertNilError(t, err)
+
+	registeredID := crypto.Keccak256Hash([]byte("Registered(bytes32,address,(bytes32,address,bool,string))"))
+
+	// schema registry contract from version 1.2 with the schema record in
+	// the Registered event
+	backend := &versionBackend{
+		Backend: h.Backend(),
+		address: h.Addresses.SchemaRegistry,
+		version: "1.2.0",
+		log: types.Log{
+			Address:     h.Addresses.SchemaRegistry,
+			Topics:      []common.Hash{registeredID, common.Hash(uid), common.BytesToHash(registerer[:])},
+			Data:        data,
+			BlockNumber: 1,
+		},
+	}
+
+	c, err := eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EAS, &eas.Options{
+		Backend: backend,
+	})
+	assertNilError(t, err)
+	assertEqual(t, "schema registry version", c.SchemaRegistryVersion(), eas.Version{Major: 1, Minor: 2})
+
+	it, err := c.SchemaRegistry.FilterRegistered(ctx, 0, nil, nil)
+	assertNilError(t, err)
+	defer it.Close()
+
+	count := 0
+	for it.Next() {
+		r := it.Value()
+		assertEqual(t, "uid", r.UID, uid)
+		assertEqual(t, "registerer", r.Registerer, registerer)
+		assertEqual(t, "schema", r.Schema, &eas.SchemaRecord{
+			UID:       uid,
+			Resolver:  resolver,
+			Revocable: true,
+			Schema:    "string message",
+		})
+		count++
+	}
+	assertNilError(t, it.Error())
+	assertEqual(t, "count", count, 1)
+}
+
+var (
+	versionSelector       = crypto.Keccak256([]byte("version()"))[:4]
+	legacyVersionSelector = crypto.Keccak256([]byte("VERSION()"))[:4]
+	versionOutput         = abi.Arguments{{Type: abi.Type{T: abi.StringTy}}}
+)
+
+// versionBackend returns the version of the contract on the address from the
+// version function, or from the VERSION constant if the legacy version is
+// set. Logs of the contract are queried from the log field.
+type versionBackend struct {
+	eas.Backend
+	address common.Address
+	version string
+	legacy  string
+	log     types.Log
+}
+
+func (b *versionBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if call.To == nil || *call.To != b.address || len(call.Data) < 4 {
+		return b.Backend.CallContract(ctx, call, blockNumber)
+	}
+	switch {
+	case bytes.Equal(call.Data[:4], versionSelector):
+		if b.legacy != "" {
+			return nil, eastest.RevertError([]byte{})
+		}
+		return versionOutput.Pack(b.version)
+	case bytes.Equal(call.Data[:4], legacyVersionSelector) && b.legacy != "":
+		return versionOutput.Pack(b.legacy)
+	}
+	return b.Backend.CallContract(ctx, call, blockNumber)
+}
+
+func (b *versionBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	if len(query.Addresses) != 1 || query.Addresses[0] != b.address {
+		return b.Backend.FilterLogs(ctx, query)
+	}
+	if len(query.Topics) == 0 || !slices.Contains(query.Topics[0], b.log.Topics[0]) {
+		return nil, nil
+	}
+	return []types.Log{b.log}, nil
+}
@@ -0,0 +1,272 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// defaultWatchPollInterval is the interval of logs queries of Watch methods
+// if Options.WatchPollInterval is not set.
+const defaultWatchPollInterval = 5 * time.Second
+
+// logWatcher delivers logs of a contract event to the sink. It subscribes to
+// new logs and resubscribes when the subscription fails, backfilling logs of
+// blocks from the last delivered one, or periodically queries logs if the
+// endpoint does not support subscriptions. Logs are delivered only once,
+// identified by their transaction hash and index.
+type logWatcher[T any] struct {
+	client *Client
+	query  ethereum.FilterQuery
+	parse  func(types.Log) (T, error)
+	sink   chan<- T
+
+	pollInterval time.Duration
+	// from is the first block with logs that may not be delivered. All logs
+	// of previous blocks are delivered.
+	from uint64
+	// delivered are logs from the from block that are delivered.
+	delivered map[logKey]struct{}
+}
+
+type logKey struct {
+	txHash common.Hash
+	index  uint
+}
+
+// fatalWatchError stops the watcher, while other errors cause resubscription.
+type fatalWatchError struct {
+	err error
+}
+
+func (e *fatalWatchError) Error() string { return e.err.Error() }
+func (e *fatalWatchError) Unwrap() error { return e.err }
+
+// watchLogs delivers logs of the contract event to the sink from the start
+// block. If the start is nil, only logs from new blocks are delivered. Rules
+// are values of indexed event arguments, in the order of arguments.
+func watchLogs[T any](ctx context.Context, client *Client, address common.Address, ev abi.Event, start *uint64, rules [][]any, parse func(types.Log) (T, error), sink chan<- T) (event.Subscription, error) {
+	query, err := eventQuery(address, ev, rules)
+	if err != nil {
+		return nil, err
+	}
+	return watchQuery(ctx, client, query, start, parse, sink)
+}
+
+// watchQuery delivers logs of the query, without the block range, to the sink
+// from the start block. If the start is nil, only logs from new blocks are
+// delivered.
+func watchQuery[T any](ctx context.Context, client *Client, query ethereum.FilterQuery, start *uint64, parse func(types.Log) (T, error), sink chan<- T) (event.Subscription, error) {
+	var from uint64
+	if start != nil {
+		from = *start
+	} else {
+		header, err := client.backend.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, fmt.Errorf("get latest block: %w", err)
+		}
+		from = header.Number.Uint64() + 1
+	}
+
+	pollInterval := client.options.WatchPollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultWatchPollInterval
+	}
+
+	w := &logWatcher[T]{
+		client:       client,
+		query:        query,
+		parse:        parse,
+		sink:         sink,
+		pollInterval: pollInterval,
+		from:         from,
+		delivered:    make(map[logKey]struct{}),
+	}
+
+	return newSubscription(ctx, w.run), nil
+}
+
+// newSubscription runs the function until it returns or the subscription is
+// unsubscribed, which cancels the function context.
+func newSubscription(ctx context.Context, run func(ctx context.Context) error) event.Subscription {
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		go func() {
+			select {
+			case <-quit:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+
+		return run(ctx)
+	})
+}
+
+// run subscribes to logs until the context is done or a fatal error occurs.
+func (w *logWatcher[T]) run(ctx context.Context) error {
+	for {
+		logs := make(chan types.Log)
+		sub, err := w.client.backend.SubscribeFilterLogs(ctx, w.query, logs)
+		if err != nil {
+			if errors.Is(err, rpc.ErrNotificationsUnsupported) {
+				return w.poll(ctx)
+			}
+			// query logs until the next subscription attempt
+			err = w.backfill(ctx)
+		} else {
+			err = w.follow(ctx, sub, logs)
+			sub.Unsubscribe()
+		}
+
+		if ctx.Err() != nil {
+			return nil
+		}
+		var fatal *fatalWatchError
+		if errors.As(err, &fatal) {
+			return fatal.err
+		}
+
+		if !waitInterval(ctx, w.pollInterval) {
+			return nil
+		}
+	}
+}
+
+// follow backfills logs from the last delivered block and delivers logs from
+// the subscription until it fails.
+func (w *logWatcher[T]) follow(ctx context.Context, sub ethereum.Subscription, logs <-chan types.Log) error {
+	if err := w.backfill(ctx); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case l := <-logs:
+			if err := w.deliver(ctx, l); err != nil {
+				return err
+			}
+		case err := <-sub.Err():
+			if err == nil {
+				err = errors.New("subscription closed")
+			}
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// poll periodically queries and delivers logs of new blocks.
+func (w *logWatcher[T]) poll(ctx context.Context) error {
+	for {
+		err := w.backfill(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		var fatal *fatalWatchError
+		if errors.As(err, &fatal) {
+			return fatal.err
+		}
+
+		if !waitInterval(ctx, w.pollInterval) {
+			return nil
+		}
+	}
+}
+
+// backfill delivers logs from the last delivered block to the latest block.
+func (w *logWatcher[T]) backfill(ctx context.Context) error {
+	header, err := w.client.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("get latest block: %w", err)
+	}
+	latest := header.Number.Uint64()
+	if w.from > latest {
+		return nil
+	}
+
+	it, err := newQueryIterator(ctx, w.client, w.query, w.from, &latest, func(l types.Log) (types.Log, error) {
+		return l, nil
+	})
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for it.Next() {
+		if err := w.deliver(ctx, it.Value()); err != nil {
+			return err
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	w.advance(latest + 1)
+	return nil
+}
+
+// deliver sends the log to the sink if it is not already delivered.
+func (w *logWatcher[T]) deliver(ctx context.Context, l types.Log) error {
+	if l.Removed || l.BlockNumber < w.from {
+		return nil
+	}
+	key := logKey{txHash: l.TxHash, index: l.Index}
+	if _, ok := w.delivered[key]; ok {
+		return nil
+	}
+
+	v, err := w.parse(l)
+	if err != nil {
+		return &fatalWatchError{err: fmt.Errorf("parse log: %w", err)}
+	}
+
+	select {
+	case w.sink <- v:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	w.advance(l.BlockNumber)
+	w.delivered[key] = struct{}{}
+	return nil
+}
+
+// advance sets the first block with logs that may not be delivered.
+func (w *logWatcher[T]) advance(block uint64) {
+	if block <= w.from {
+		return
+	}
+	w.from = block
+	clear(w.delivered)
+}
+
+// waitInterval blocks for the duration and reports if the context is not
+// done.
+func waitInterval(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
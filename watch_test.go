@@ -0,0 +1,187 @@
+// Copyright (c) 2024, Janoš Guljaš <janos@resenje.org>
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package eas_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"resenje.org/eas"
+	"resenje.org/eas/eastest"
+)
+
+func TestEASContract_WatchAttested_resilient(t *testing.T) {
+	ctx := context.Background()
+
+	h := eastest.New(t, &eastest.Options{AutoCommit: true})
+
+	_, wait, err := h.Client().SchemaRegistry.Register(ctx, "string message", [20]byte{}, true)
+	assertNilError(t, err)
+	r, err := wait(ctx)
+	assertNilError(t, err)
+	schemaUID := r.UID
+
+	count := 0
+	attest := func(t *testing.T) eas.UID {
+		t.Helper()
+
+		_, wait, err := h.Client().EAS.Attest(ctx, schemaUID, nil, fmt.Sprintf("Hello %v!", count))
+		assertNilError(t, err)
+		r, err := wait(ctx)
+		assertNilError(t, err)
+		count++
+		return r.UID
+	}
+
+	receive := func(t *testing.T, sink <-chan *eas.EASAttested, n int) []eas.UID {
+		t.Helper()
+
+		var uids []eas.UID
+		for range n {
+			select {
+			case r := <-sink:
+				uids = append(uids, r.UID)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("got %v attestations, want %v", len(uids), n)
+			}
+		}
+		return uids
+	}
+
+	assertNoMore := func(t *testing.T, sink <-chan *eas.EASAttested) {
+		t.Helper()
+
+		select {
+		case r := <-sink:
+			t.Fatalf("unexpected attestation %s", r.UID)
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	t.Run("resubscribe", func(t *testing.T) {
+		backend := &dropSubscriptionBackend{Backend: h.Backend()}
+
+		c, err := eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EAS, &eas.Options{
+			Backend:           backend,
+			WatchPollInterval: 20 * time.Millisecond,
+		})
+		assertNilError(t, err)
+
+		sink := make(chan *eas.EASAttested, 10)
+		sub, err := c.EAS.WatchAttested(ctx, nil, sink, nil, nil, nil)
+		assertNilError(t, err)
+		defer sub.Unsubscribe()
+
+		want := []eas.UID{attest(t), attest(t)}
+		assertEqual(t, "uids", receive(t, sink, 2), want)
+
+		// attestations while the subscription is lost are backfilled
+		backend.drop()
+		want = []eas.UID{attest(t), attest(t)}
+		assertEqual(t, "uids", receive(t, sink, 2), want)
+
+		want = []eas.UID{attest(t)}
+		assertEqual(t, "uids", receive(t, sink, 1), want)
+
+		assertNoMore(t, sink)
+
+		if s := backend.subscriptions(); s < 2 {
+			t.Errorf("got %v subscriptions, want at least 2", s)
+		}
+	})
+
+	t.Run("polling", func(t *testing.T) {
+		backend := eastest.NewFaultBackend(h.Simulated, h.Backend())
+		backend.Inject(eastest.Fault{
+			Method: "SubscribeFilterLogs",
+			Err:    rpc.ErrNotificationsUnsupported,
+		})
+
+		c, err := eas.NewClient(ctx, "", h.Accounts[0].PrivateKey, h.Addresses.EAS, &eas.Options{
+			Backend:           backend,
+			WatchPollInterval: 10 * time.Millisecond,
+		})
+		assertNilError(t, err)
+
+		header, err := h.Simulated.Client().HeaderByNumber(ctx, nil)
+		assertNilError(t, err)
+		start := header.Number.Uint64() + 1
+
+		want := []eas.UID{attest(t)}
+
+		sink := make(chan *eas.EASAttested, 10)
+		sub, err := c.EAS.WatchAttested(ctx, &start, sink, nil, nil, nil)
+		assertNilError(t, err)
+		defer sub.Unsubscribe()
+
+		want = append(want, attest(t), attest(t))
+		assertEqual(t, "uids", receive(t, sink, 3), want)
+
+		assertNoMore(t, sink)
+
+		assertEqual(t, "subscriptions", backend.Calls("SubscribeFilterLogs"), 1)
+	})
+}
+
+// dropSubscriptionBackend returns subscriptions that can be failed as if the
+// connection to the endpoint is lost.
+type dropSubscriptionBackend struct {
+	eas.Backend
+
+	mu    sync.Mutex
+	subs  []*droppableSubscription
+	count int
+}
+
+func (b *dropSubscriptionBackend) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	sub, err := b.Backend.SubscribeFilterLogs(ctx, q, ch)
+	if err != nil {
+		return nil, err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	s := &droppableSubscription{Subscription: sub, err: make(chan error, 1)}
+	b.subs = append(b.subs, s)
+	b.count++
+	return s, nil
+}
+
+func (b *dropSubscriptionBackend) drop() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, s := range b.subs {
+		s.Subscription.Unsubscribe()
+		s.err <- errors.New("connection lost")
+	}
+	b.subs = nil
+}
+
+func (b *dropSubscriptionBackend) subscriptions() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.count
+}
+
+type droppableSubscription struct {
+	ethereum.Subscription
+	err chan error
+}
+
+func (s *droppableSubscription) Err() <-chan error {
+	return s.err
+}